@@ -0,0 +1,164 @@
+package azurestack
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/adal"
+	"github.com/hashicorp/go-azure-helpers/authentication"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// providerConfigureOidc builds an *ArmClient by authenticating using an OIDC / workload identity
+// federation token, as an alternative to a Client Secret or Certificate. This bypasses
+// `authentication.Builder`, which doesn't support this authentication method.
+func providerConfigureOidc(d *schema.ResourceData, armEndpoint, tfVersion string, skipProviderRegistration bool, tls senderSettings, partnerID string, disableCorrelationRequestID bool, userAgentSuffix, storageEndpointSuffix, keyVaultDNSSuffix string) (*ArmClient, error) {
+	clientID := d.Get("client_id").(string)
+	tenantID := d.Get("tenant_id").(string)
+	subscriptionID := d.Get("subscription_id").(string)
+
+	if clientID == "" || tenantID == "" {
+		return nil, fmt.Errorf("`client_id` and `tenant_id` must both be set when `use_oidc` is enabled")
+	}
+
+	idToken, err := loadOidcToken(d.Get("oidc_token").(string), d.Get("oidc_token_file_path").(string))
+	if err != nil {
+		return nil, err
+	}
+
+	env, err := authentication.LoadEnvironmentFromUrl(armEndpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	if storageEndpointSuffix != "" {
+		env.StorageEndpointSuffix = storageEndpointSuffix
+	}
+	if keyVaultDNSSuffix != "" {
+		env.KeyVaultDNSSuffix = keyVaultDNSSuffix
+	}
+
+	oidcCfg := oidcAuthConfig{
+		ClientID: clientID,
+		TenantID: tenantID,
+		IDToken:  idToken,
+	}
+
+	requestSender, err := buildSender("AzureStack", tls)
+	if err != nil {
+		return nil, err
+	}
+
+	auth, err := buildOidcAuthorizer(requestSender, env.ActiveDirectoryEndpoint, env.TokenAudience, oidcCfg)
+	if err != nil {
+		return nil, fmt.Errorf("Error obtaining an Azure Resource Manager token via OIDC: %+v", err)
+	}
+
+	graphAuth, err := buildOidcAuthorizer(requestSender, env.ActiveDirectoryEndpoint, env.GraphEndpoint, oidcCfg)
+	if err != nil {
+		return nil, fmt.Errorf("Error obtaining a Graph token via OIDC: %+v", err)
+	}
+
+	return buildArmClient(*env, tfVersion, subscriptionID, tenantID, clientID, true, skipProviderRegistration, requestSender, auth, graphAuth, partnerID, disableCorrelationRequestID, userAgentSuffix)
+}
+
+// oidcAuthConfig contains the values required to authenticate against Azure Stack using an
+// OIDC / workload identity federation token (for example one issued by GitHub Actions or
+// GitLab CI) rather than a long-lived Client Secret or Certificate.
+type oidcAuthConfig struct {
+	ClientID string
+	TenantID string
+	IDToken  string
+}
+
+// buildOidcAuthorizer exchanges the supplied OIDC ID token for an Azure Active Directory access
+// token for the given resource, using the JWT Bearer / client assertion flow described in
+// https://tools.ietf.org/html/rfc7523 (the mechanism used by Azure AD Workload Identity Federation).
+func buildOidcAuthorizer(sender autorest.Sender, activeDirectoryEndpoint, resource string, cfg oidcAuthConfig) (autorest.Authorizer, error) {
+	oauthConfig, err := adal.NewOAuthConfig(activeDirectoryEndpoint, cfg.TenantID)
+	if err != nil {
+		return nil, fmt.Errorf("Error building OAuth Config for OIDC authentication: %+v", err)
+	}
+
+	token, err := oidcExchangeToken(sender, oauthConfig.TokenEndpoint.String(), cfg.ClientID, cfg.IDToken, resource)
+	if err != nil {
+		return nil, err
+	}
+
+	spt, err := adal.NewServicePrincipalTokenFromManualToken(*oauthConfig, cfg.ClientID, resource, token)
+	if err != nil {
+		return nil, fmt.Errorf("Error building a Service Principal Token from the OIDC token exchange: %+v", err)
+	}
+
+	return autorest.NewBearerAuthorizer(spt), nil
+}
+
+// oidcExchangeToken performs the OAuth2 client credentials grant, using the OIDC ID token as the
+// `client_assertion` in place of a Client Secret or Certificate.
+//
+// NOTE: the token returned by this flow doesn't come with a refresh token - so unlike the other
+// authentication methods the resulting Authorizer won't proactively refresh once the (typically
+// short-lived) OIDC-issued access token expires. Terraform runs are expected to complete within
+// that window.
+func oidcExchangeToken(sender autorest.Sender, tokenEndpoint, clientID, idToken, resource string) (adal.Token, error) {
+	var token adal.Token
+
+	body := url.Values{
+		"grant_type":            {"client_credentials"},
+		"client_id":             {clientID},
+		"client_assertion_type": {"urn:ietf:params:oauth:client-assertion-type:jwt-bearer"},
+		"client_assertion":      {idToken},
+		"resource":              {resource},
+	}
+
+	req, err := http.NewRequest(http.MethodPost, tokenEndpoint, strings.NewReader(body.Encode()))
+	if err != nil {
+		return token, fmt.Errorf("Error building the OIDC token exchange request: %+v", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := sender.Do(req)
+	if err != nil {
+		return token, fmt.Errorf("Error exchanging the OIDC token: %+v", err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return token, fmt.Errorf("Error reading the OIDC token exchange response: %+v", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return token, fmt.Errorf("Error exchanging the OIDC token (status %d): %s", resp.StatusCode, string(respBody))
+	}
+
+	if err := json.Unmarshal(respBody, &token); err != nil {
+		return token, fmt.Errorf("Error parsing the OIDC token exchange response: %+v", err)
+	}
+
+	return token, nil
+}
+
+// loadOidcToken returns the raw OIDC ID token which should be used to authenticate, either taken
+// directly from `oidc_token` or read from the file at `oidc_token_file_path`.
+func loadOidcToken(token, tokenFilePath string) (string, error) {
+	if token != "" {
+		return token, nil
+	}
+
+	if tokenFilePath != "" {
+		contents, err := ioutil.ReadFile(tokenFilePath)
+		if err != nil {
+			return "", fmt.Errorf("Error reading the OIDC token from %q: %+v", tokenFilePath, err)
+		}
+		return strings.TrimSpace(string(contents)), nil
+	}
+
+	return "", fmt.Errorf("`use_oidc` was set but neither `oidc_token` nor `oidc_token_file_path` (nor the " +
+		"`ARM_OIDC_TOKEN`/`ARM_OIDC_TOKEN_FILE_PATH` Environment Variables) were set")
+}
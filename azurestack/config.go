@@ -3,6 +3,7 @@ package azurestack
 import (
 	"context"
 	"fmt"
+	"log"
 	"os"
 	"sync"
 	"time"
@@ -17,11 +18,16 @@ import (
 	"github.com/Azure/go-autorest/autorest"
 	"github.com/Azure/go-autorest/autorest/azure"
 	"github.com/hashicorp/go-azure-helpers/authentication"
-	"github.com/hashicorp/go-azure-helpers/sender"
+	"github.com/hashicorp/go-uuid"
 	"github.com/hashicorp/terraform-plugin-sdk/httpclient"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
+// supportedApiProfile is the hybrid API profile whose SDK packages this build of the Provider is
+// compiled against (see the imports above) - Azure Stack Hub build versions which require a
+// different profile need a Provider build compiled against that profile's SDK packages instead.
+const supportedApiProfile = "2019-03-01-hybrid"
+
 // ArmClient contains the handles to all the specific Azure Resource Manager
 // resource classes' respective clients.
 type ArmClient struct {
@@ -33,6 +39,42 @@ type ArmClient struct {
 	environment              azure.Environment
 	skipProviderRegistration bool
 
+	// partnerID is a GUID used to attribute usage of the Provider to a partner, included as a
+	// `pid-<partnerID>` suffix on the User-Agent string sent to Azure Stack.
+	partnerID string
+
+	// userAgentSuffix is appended verbatim to the User-Agent string sent to Azure Stack, so that
+	// operators can attribute traffic from different pipelines/environments when reviewing stamp
+	// gateway logs.
+	userAgentSuffix string
+
+	// disableCorrelationRequestID controls whether an `x-ms-correlation-request-id` header is sent
+	// with every request, for operators who must not send correlation headers.
+	disableCorrelationRequestID bool
+
+	// correlationRequestID is generated once when the client is built and sent as the
+	// `x-ms-correlation-request-id` header on every request made by every client below, so that an
+	// entire `terraform apply` can be traced as a single operation in the stamp's activity log.
+	correlationRequestID string
+
+	// features controls the deletion-safety behaviors configured via the Provider's `features`
+	// block.
+	features Features
+
+	// defaultTags are merged into the `tags` of every taggable resource, with the Resource's own
+	// `tags` winning on any conflicting key.
+	defaultTags map[string]string
+
+	// validLocations is the set of normalized locations (see azureStackNormalizeLocation) the
+	// stamp's Resource Providers reported support for, gathered as a side effect of the provider
+	// registration list fetched during Configure. Empty when `skip_credentials_validation` is set,
+	// in which case location validation against the stamp is skipped entirely.
+	validLocations map[string]struct{}
+
+	// sender is the autorest.Sender used when registering every client below, so that TLS
+	// configuration (e.g. a custom CA bundle) is applied consistently across all of them.
+	sender autorest.Sender
+
 	StopContext context.Context
 
 	// Authentication
@@ -66,24 +108,37 @@ type ArmClient struct {
 	storageServiceClient storage.AccountsClient
 
 	// Network
-	vnetClient         network.VirtualNetworksClient
-	secGroupClient     network.SecurityGroupsClient
-	publicIPClient     network.PublicIPAddressesClient
-	subnetClient       network.SubnetsClient
-	loadBalancerClient network.LoadBalancersClient
-	routesClient       network.RoutesClient
-	routeTablesClient  network.RouteTablesClient
+	vnetClient                      network.VirtualNetworksClient
+	vnetPeeringClient               network.VirtualNetworkPeeringsClient
+	secGroupClient                  network.SecurityGroupsClient
+	applicationSecurityGroupsClient network.ApplicationSecurityGroupsClient
+	publicIPClient                  network.PublicIPAddressesClient
+	subnetClient                    network.SubnetsClient
+	loadBalancerClient              network.LoadBalancersClient
+	routesClient                    network.RoutesClient
+	routeTablesClient               network.RouteTablesClient
+
+	// LoadBalancer sub-resources - used only to read a single NAT Rule/Probe/Rule/Backend Address
+	// Pool without a full LoadBalancer GET, since CreateOrUpdate/Delete still have to go through the
+	// parent LoadBalancer to keep its sub-resource collections consistent
+	loadBalancerNatRuleClient     network.InboundNatRulesClient
+	loadBalancerProbeClient       network.LoadBalancerProbesClient
+	loadBalancerRuleClient        network.LoadBalancerLoadBalancingRulesClient
+	loadBalancerBackendPoolClient network.LoadBalancerBackendAddressPoolsClient
 }
 
 func (c *ArmClient) configureClient(client *autorest.Client, auth autorest.Authorizer) {
-	setUserAgent(client, c.terraformVersion)
+	setUserAgent(client, c.terraformVersion, c.partnerID, c.userAgentSuffix)
 	client.Authorizer = auth
-	client.Sender = sender.BuildSender("AzureStack")
+	client.Sender = c.sender
 	client.SkipResourceProviderRegistration = c.skipProviderRegistration
 	client.PollingDuration = 60 * time.Minute
+	if !c.disableCorrelationRequestID {
+		client.RequestInspector = withCorrelationRequestID(c.correlationRequestID)
+	}
 }
 
-func setUserAgent(client *autorest.Client, tfVersion string) {
+func setUserAgent(client *autorest.Client, tfVersion, partnerID, userAgentSuffix string) {
 	tfUserAgent := httpclient.TerraformUserAgent(tfVersion)
 
 	// if the user agent already has a value append the Terraform user agent string
@@ -97,25 +152,35 @@ func setUserAgent(client *autorest.Client, tfVersion string) {
 	if azureAgent := os.Getenv("AZURE_HTTP_USER_AGENT"); azureAgent != "" {
 		client.UserAgent = fmt.Sprintf("%s %s", client.UserAgent, azureAgent)
 	}
+
+	// append the partner ID, used to attribute usage of the Provider to a partner, if one is set
+	if partnerID != "" {
+		client.UserAgent = fmt.Sprintf("%s pid-%s", client.UserAgent, partnerID)
+	}
+
+	// append the operator-supplied suffix last, so it's always the trailing token an operator can
+	// grep stamp gateway logs for
+	if userAgentSuffix != "" {
+		client.UserAgent = fmt.Sprintf("%s %s", client.UserAgent, userAgentSuffix)
+	}
 }
 
 // getArmClient is a helper method which returns a fully instantiated
 // *ArmClient based on the Config's current settings.
-func getArmClient(authCfg *authentication.Config, tfVersion string, skipProviderRegistration bool) (*ArmClient, error) {
+func getArmClient(authCfg *authentication.Config, tfVersion string, skipProviderRegistration bool, tls senderSettings, partnerID string, disableCorrelationRequestID bool, userAgentSuffix, storageEndpointSuffix, keyVaultDNSSuffix string) (*ArmClient, error) {
 	env, err := authentication.LoadEnvironmentFromUrl(authCfg.CustomResourceManagerEndpoint)
 	if err != nil {
 		return nil, err
 	}
 
-	// client declarations:
-	client := ArmClient{
-		clientId:                 authCfg.ClientID,
-		tenantId:                 authCfg.TenantID,
-		subscriptionId:           authCfg.SubscriptionID,
-		terraformVersion:         tfVersion,
-		environment:              *env,
-		usingServicePrincipal:    authCfg.AuthenticatedAsAServicePrincipal,
-		skipProviderRegistration: skipProviderRegistration,
+	// the metadata discovered above derives these suffixes from the stamp's `arm_endpoint`, which
+	// can be wrong for stamps with a custom DNS zone or an external FQDN that doesn't match the
+	// region name baked into the default suffix - let operators override them explicitly
+	if storageEndpointSuffix != "" {
+		env.StorageEndpointSuffix = storageEndpointSuffix
+	}
+	if keyVaultDNSSuffix != "" {
+		env.KeyVaultDNSSuffix = keyVaultDNSSuffix
 	}
 
 	oauth, err := authCfg.BuildOAuthConfig(env.ActiveDirectoryEndpoint)
@@ -123,25 +188,65 @@ func getArmClient(authCfg *authentication.Config, tfVersion string, skipProvider
 		return nil, err
 	}
 
-	sender := sender.BuildSender("AzureStack")
-
-	// Resource Manager endpoints
-	endpoint := env.ResourceManagerEndpoint
+	requestSender, err := buildSender("AzureStack", tls)
+	if err != nil {
+		return nil, err
+	}
 
 	// Instead of the same endpoint use token audience to get the correct token.
-	auth, err := authCfg.GetAuthorizationToken(sender, oauth, env.TokenAudience)
+	//
+	// `auth` wraps a single underlying adal.ServicePrincipalToken which is shared - by reference -
+	// across every ARM client registered on the ArmClient below, rather than each client acquiring
+	// its own token: a refresh triggered by one client is immediately visible to every other client
+	// sharing this Authorizer. adal proactively refreshes that token on every request whose access
+	// token is within 5 minutes of expiry (see adal.ServicePrincipalToken.EnsureFresh), so a long
+	// `apply` - such as a Virtual Machine plus Extensions - keeps renewing its token as it goes
+	// rather than only discovering the token has expired once the hour is up.
+	auth, err := authCfg.GetAuthorizationToken(requestSender, oauth, env.TokenAudience)
 	if err != nil {
 		return nil, err
 	}
 
-	// Graph Endpoints
-	graphEndpoint := env.GraphEndpoint
-	graphAuth, err := authCfg.GetAuthorizationToken(sender, oauth, graphEndpoint)
+	graphAuth, err := authCfg.GetAuthorizationToken(requestSender, oauth, env.GraphEndpoint)
 	if err != nil {
 		return nil, err
 	}
 
-	client.registerAuthentication(graphEndpoint, client.tenantId, graphAuth, sender)
+	return buildArmClient(*env, tfVersion, authCfg.SubscriptionID, authCfg.TenantID, authCfg.ClientID,
+		authCfg.AuthenticatedAsAServicePrincipal, skipProviderRegistration, requestSender, auth, graphAuth,
+		partnerID, disableCorrelationRequestID, userAgentSuffix)
+}
+
+// buildArmClient assembles an *ArmClient from a set of already-obtained Authorizers, so that
+// alternative authentication flows (such as OIDC / workload identity federation, which isn't
+// supported by `authentication.Builder`) can reuse the same client wiring as the primary
+// Service Principal / Client Certificate / Azure CLI code path.
+func buildArmClient(env azure.Environment, tfVersion, subscriptionId, tenantId, clientId string, usingServicePrincipal, skipProviderRegistration bool, requestSender autorest.Sender, auth, graphAuth autorest.Authorizer, partnerID string, disableCorrelationRequestID bool, userAgentSuffix string) (*ArmClient, error) {
+	correlationRequestID, err := uuid.GenerateUUID()
+	if err != nil {
+		return nil, fmt.Errorf("Error generating a correlation request ID: %+v", err)
+	}
+	log.Printf("[TRACE] azurestack: using correlation request ID %q for this operation", correlationRequestID)
+
+	client := ArmClient{
+		clientId:                    clientId,
+		tenantId:                    tenantId,
+		subscriptionId:              subscriptionId,
+		terraformVersion:            tfVersion,
+		environment:                 env,
+		usingServicePrincipal:       usingServicePrincipal,
+		skipProviderRegistration:    skipProviderRegistration,
+		sender:                      requestSender,
+		partnerID:                   partnerID,
+		disableCorrelationRequestID: disableCorrelationRequestID,
+		correlationRequestID:        correlationRequestID,
+		userAgentSuffix:             userAgentSuffix,
+	}
+
+	// Resource Manager endpoints
+	endpoint := env.ResourceManagerEndpoint
+
+	client.registerAuthentication(env.GraphEndpoint, client.tenantId, graphAuth, requestSender)
 	client.registerComputeClients(endpoint, client.subscriptionId, auth)
 	client.registerDNSClients(endpoint, client.subscriptionId, auth)
 	client.registerNetworkingClients(endpoint, client.subscriptionId, auth)
@@ -153,10 +258,13 @@ func getArmClient(authCfg *authentication.Config, tfVersion string, skipProvider
 
 func (c *ArmClient) registerAuthentication(graphEndpoint, tenantId string, graphAuth autorest.Authorizer, sender autorest.Sender) {
 	servicePrincipalsClient := graphrbac.NewServicePrincipalsClientWithBaseURI(graphEndpoint, tenantId)
-	setUserAgent(&servicePrincipalsClient.Client, c.terraformVersion)
+	setUserAgent(&servicePrincipalsClient.Client, c.terraformVersion, c.partnerID, c.userAgentSuffix)
 	servicePrincipalsClient.Authorizer = graphAuth
 	servicePrincipalsClient.Sender = sender
 	servicePrincipalsClient.SkipResourceProviderRegistration = c.skipProviderRegistration
+	if !c.disableCorrelationRequestID {
+		servicePrincipalsClient.RequestInspector = withCorrelationRequestID(c.correlationRequestID)
+	}
 	c.servicePrincipalsClient = servicePrincipalsClient
 }
 
@@ -217,10 +325,30 @@ func (c *ArmClient) registerNetworkingClients(endpoint, subscriptionId string, a
 	c.configureClient(&loadBalancersClient.Client, auth)
 	c.loadBalancerClient = loadBalancersClient
 
+	loadBalancerNatRulesClient := network.NewInboundNatRulesClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&loadBalancerNatRulesClient.Client, auth)
+	c.loadBalancerNatRuleClient = loadBalancerNatRulesClient
+
+	loadBalancerProbesClient := network.NewLoadBalancerProbesClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&loadBalancerProbesClient.Client, auth)
+	c.loadBalancerProbeClient = loadBalancerProbesClient
+
+	loadBalancerRulesClient := network.NewLoadBalancerLoadBalancingRulesClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&loadBalancerRulesClient.Client, auth)
+	c.loadBalancerRuleClient = loadBalancerRulesClient
+
+	loadBalancerBackendPoolsClient := network.NewLoadBalancerBackendAddressPoolsClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&loadBalancerBackendPoolsClient.Client, auth)
+	c.loadBalancerBackendPoolClient = loadBalancerBackendPoolsClient
+
 	networksClient := network.NewVirtualNetworksClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&networksClient.Client, auth)
 	c.vnetClient = networksClient
 
+	vnetPeeringsClient := network.NewVirtualNetworkPeeringsClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&vnetPeeringsClient.Client, auth)
+	c.vnetPeeringClient = vnetPeeringsClient
+
 	publicIPAddressesClient := network.NewPublicIPAddressesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&publicIPAddressesClient.Client, auth)
 	c.publicIPClient = publicIPAddressesClient
@@ -229,6 +357,10 @@ func (c *ArmClient) registerNetworkingClients(endpoint, subscriptionId string, a
 	c.configureClient(&securityGroupsClient.Client, auth)
 	c.secGroupClient = securityGroupsClient
 
+	applicationSecurityGroupsClient := network.NewApplicationSecurityGroupsClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&applicationSecurityGroupsClient.Client, auth)
+	c.applicationSecurityGroupsClient = applicationSecurityGroupsClient
+
 	securityRulesClient := network.NewSecurityRulesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&securityRulesClient.Client, auth)
 	c.secRuleClient = securityRulesClient
@@ -0,0 +1,145 @@
+package azurestack
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// customizeDiffAll combines multiple CustomizeDiffFuncs into one, running each in turn and
+// returning the first error encountered - so a resource can compose several of the validations
+// below instead of hand-rolling one CustomizeDiff function per resource.
+func customizeDiffAll(funcs ...schema.CustomizeDiffFunc) schema.CustomizeDiffFunc {
+	return func(diff *schema.ResourceDiff, meta interface{}) error {
+		for _, f := range funcs {
+			if err := f(diff, meta); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+}
+
+// customizeDiffRequiredTogether returns a CustomizeDiffFunc which errors at plan time if some but
+// not all of fields are set, catching a combination that would otherwise only surface as an ARM
+// 400 at apply.
+func customizeDiffRequiredTogether(fields ...string) schema.CustomizeDiffFunc {
+	return func(diff *schema.ResourceDiff, meta interface{}) error {
+		var set, unset []string
+
+		for _, field := range fields {
+			if _, ok := diff.GetOk(field); ok {
+				set = append(set, field)
+			} else {
+				unset = append(unset, field)
+			}
+		}
+
+		if len(set) > 0 && len(unset) > 0 {
+			return fmt.Errorf("`%s` must be specified when `%s` is set", unset[0], set[0])
+		}
+
+		return nil
+	}
+}
+
+// customizeDiffAtLeastOne returns a CustomizeDiffFunc which errors at plan time unless at least one
+// of fields is set, catching an empty/no-op configuration before it reaches an ARM apply.
+func customizeDiffAtLeastOne(fields ...string) schema.CustomizeDiffFunc {
+	return func(diff *schema.ResourceDiff, meta interface{}) error {
+		for _, field := range fields {
+			if _, ok := diff.GetOk(field); ok {
+				return nil
+			}
+		}
+
+		return fmt.Errorf("one of `%s` must be specified", strings.Join(fields, "`, `"))
+	}
+}
+
+// customizeDiffLoadBalancerNatPoolPortRange requires frontend_port_start to be no greater than
+// frontend_port_end, catching a reversed range at plan time rather than an ARM 400 at apply.
+func customizeDiffLoadBalancerNatPoolPortRange(diff *schema.ResourceDiff, meta interface{}) error {
+	start, hasStart := diff.GetOk("frontend_port_start")
+	end, hasEnd := diff.GetOk("frontend_port_end")
+	if !hasStart || !hasEnd {
+		return nil
+	}
+
+	if start.(int) > end.(int) {
+		return fmt.Errorf("`frontend_port_start` (%d) must be less than or equal to `frontend_port_end` (%d)", start.(int), end.(int))
+	}
+
+	return nil
+}
+
+// customizeDiffMutuallyExclusive returns a CustomizeDiffFunc which errors at plan time if more
+// than one of fields is set, catching a singular/plural combination (e.g. `source_port_range` and
+// `source_port_ranges`) that the API would otherwise reject at apply.
+func customizeDiffMutuallyExclusive(fields ...string) schema.CustomizeDiffFunc {
+	return func(diff *schema.ResourceDiff, meta interface{}) error {
+		var set []string
+
+		for _, field := range fields {
+			if _, ok := diff.GetOk(field); ok {
+				set = append(set, field)
+			}
+		}
+
+		if len(set) > 1 {
+			return fmt.Errorf("only one of `%s` can be specified", strings.Join(set, "`, `"))
+		}
+
+		return nil
+	}
+}
+
+// customizeDiffTagsWithDefaultTags errors at plan time if a resource's own `tags` combined with the
+// Provider's `default_tags` would exceed the 15 tags ARM allows on a single resource.
+// validateAzureStackTags only caps `tags` and `default_tags` independently, so a config that's valid
+// on both fields individually can still overflow once expandTags merges them - without this check
+// that combination only surfaces as an opaque ARM 400 at apply.
+func customizeDiffTagsWithDefaultTags(diff *schema.ResourceDiff, meta interface{}) error {
+	tags, ok := diff.Get("tags").(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	defaultTags := meta.(*ArmClient).defaultTags
+
+	combined := make(map[string]struct{}, len(tags)+len(defaultTags))
+	for k := range tags {
+		combined[k] = struct{}{}
+	}
+	for k := range defaultTags {
+		combined[k] = struct{}{}
+	}
+
+	if len(combined) > 15 {
+		return fmt.Errorf("a maximum of 15 tags can be applied to each ARM resource, but this resource's `tags` combined with the Provider's `default_tags` resolve to %d", len(combined))
+	}
+
+	return nil
+}
+
+// customizeDiffRequiredWithValue returns a CustomizeDiffFunc which requires every field in
+// requiredFields to be set whenever field is set to value, catching invalid combinations (for
+// example a `type` argument which determines which other arguments are mandatory) at plan time
+// instead of an ARM 400 at apply.
+func customizeDiffRequiredWithValue(field, value string, requiredFields ...string) schema.CustomizeDiffFunc {
+	return func(diff *schema.ResourceDiff, meta interface{}) error {
+		if diff.Get(field).(string) != value {
+			return nil
+		}
+
+		for _, requiredField := range requiredFields {
+			if _, ok := diff.GetOk(requiredField); !ok {
+				return fmt.Errorf("`%s` must be specified when `%s` is set to %q", requiredField, field, value)
+			}
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,58 @@
+package azurestack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccDataAzureStackDnsARecord_basic(t *testing.T) {
+	dataSourceName := "data.azurestack_dns_a_record.test"
+	ri := acctest.RandInt()
+
+	config := testAccDataAzureStackDnsARecordBasic(ri, testLocation())
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "records.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "ttl", "300"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataAzureStackDnsARecordBasic(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurestack_resource_group" "test" {
+  name     = "acctestRG_%d"
+  location = "%s"
+}
+
+resource "azurestack_dns_zone" "test" {
+  name                = "acctestzone%d.com"
+  resource_group_name = "${azurestack_resource_group.test.name}"
+}
+
+resource "azurestack_dns_a_record" "test" {
+  name                = "myarecord%d"
+  resource_group_name = "${azurestack_resource_group.test.name}"
+  zone_name           = "${azurestack_dns_zone.test.name}"
+  ttl                 = 300
+  records             = ["1.2.3.4", "1.2.4.5"]
+}
+
+data "azurestack_dns_a_record" "test" {
+  name                = "${azurestack_dns_a_record.test.name}"
+  zone_name           = "${azurestack_dns_zone.test.name}"
+  resource_group_name = "${azurestack_resource_group.test.name}"
+}
+`, rInt, location, rInt, rInt)
+}
@@ -0,0 +1,58 @@
+package azurestack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccDataAzureStackDnsCNameRecord_basic(t *testing.T) {
+	dataSourceName := "data.azurestack_dns_cname_record.test"
+	ri := acctest.RandInt()
+
+	config := testAccDataAzureStackDnsCNameRecordBasic(ri, testLocation())
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "record", "contoso.com"),
+					resource.TestCheckResourceAttr(dataSourceName, "ttl", "300"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataAzureStackDnsCNameRecordBasic(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurestack_resource_group" "test" {
+  name     = "acctestRG_%d"
+  location = "%s"
+}
+
+resource "azurestack_dns_zone" "test" {
+  name                = "acctestzone%d.com"
+  resource_group_name = "${azurestack_resource_group.test.name}"
+}
+
+resource "azurestack_dns_cname_record" "test" {
+  name                = "mycnamerecord%d"
+  resource_group_name = "${azurestack_resource_group.test.name}"
+  zone_name           = "${azurestack_dns_zone.test.name}"
+  ttl                 = 300
+  record              = "contoso.com"
+}
+
+data "azurestack_dns_cname_record" "test" {
+  name                = "${azurestack_dns_cname_record.test.name}"
+  zone_name           = "${azurestack_dns_zone.test.name}"
+  resource_group_name = "${azurestack_resource_group.test.name}"
+}
+`, rInt, location, rInt, rInt)
+}
@@ -0,0 +1,85 @@
+package azurestack
+
+import (
+	"fmt"
+
+	"github.com/Azure/azure-sdk-for-go/services/dns/mgmt/2016-04-01/dns"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func dataSourceArmDnsTxtRecord() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmDnsTxtRecordRead,
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"resource_group_name": resourceGroupNameForDataSourceSchema(),
+
+			"zone_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"record": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"value": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+				Set: resourceArmDnsTxtRecordHash,
+			},
+
+			"ttl": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"tags": tagsForDataSourceSchema(),
+		},
+	}
+}
+
+func dataSourceArmDnsTxtRecordRead(d *schema.ResourceData, meta interface{}) error {
+	dnsClient := meta.(*ArmClient).dnsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	name := d.Get("name").(string)
+	resGroup := d.Get("resource_group_name").(string)
+	zoneName := d.Get("zone_name").(string)
+
+	resp, err := dnsClient.Get(ctx, resGroup, zoneName, name, dns.TXT)
+	if err != nil {
+		if wasNotFound(resp.Response) {
+			return fmt.Errorf("Error: DNS TXT Record %q (Zone %q / Resource Group %q) was not found", name, zoneName, resGroup)
+		}
+		return fmt.Errorf("Error reading DNS TXT record %s: %+v", name, err)
+	}
+
+	if resp.ID == nil {
+		return fmt.Errorf("Cannot read DNS TXT Record %s (resource group %s) ID", name, resGroup)
+	}
+
+	d.SetId(*resp.ID)
+
+	d.Set("name", name)
+	d.Set("resource_group_name", resGroup)
+	d.Set("zone_name", zoneName)
+	d.Set("ttl", resp.TTL)
+
+	if err := d.Set("record", flattenAzureStackDnsTxtRecords(resp.TxtRecords)); err != nil {
+		return err
+	}
+	flattenAndSetTags(d, &resp.Metadata)
+
+	return nil
+}
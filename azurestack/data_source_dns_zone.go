@@ -0,0 +1,75 @@
+package azurestack
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func dataSourceArmDnsZone() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmDnsZoneRead,
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"resource_group_name": resourceGroupNameForDataSourceSchema(),
+
+			"number_of_record_sets": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"max_number_of_record_sets": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"name_servers": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+
+			"tags": tagsForDataSourceSchema(),
+		},
+	}
+}
+
+func dataSourceArmDnsZoneRead(d *schema.ResourceData, meta interface{}) error {
+	zonesClient := meta.(*ArmClient).zonesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	resGroup := d.Get("resource_group_name").(string)
+	name := d.Get("name").(string)
+
+	resp, err := zonesClient.Get(ctx, resGroup, name)
+	if err != nil {
+		if wasNotFound(resp.Response) {
+			return fmt.Errorf("Error: DNS Zone %q (Resource Group %q) was not found", name, resGroup)
+		}
+		return fmt.Errorf("Error reading DNS zone %s (resource group %s): %+v", name, resGroup, err)
+	}
+
+	d.SetId(*resp.ID)
+
+	d.Set("name", name)
+	d.Set("resource_group_name", resGroup)
+	d.Set("number_of_record_sets", resp.NumberOfRecordSets)
+	d.Set("max_number_of_record_sets", resp.MaxNumberOfRecordSets)
+
+	if nameServers := resp.NameServers; nameServers != nil {
+		if err := d.Set("name_servers", *nameServers); err != nil {
+			return fmt.Errorf("Error setting `name_servers`: %+v", err)
+		}
+	}
+
+	flattenAndSetTags(d, &resp.Tags)
+
+	return nil
+}
@@ -0,0 +1,50 @@
+package azurestack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccDataAzureStackDnsZone_basic(t *testing.T) {
+	dataSourceName := "data.azurestack_dns_zone.test"
+	ri := acctest.RandInt()
+
+	config := testAccDataAzureStackDnsZoneBasic(ri, testLocation())
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "number_of_record_sets"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "max_number_of_record_sets"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "name_servers.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataAzureStackDnsZoneBasic(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurestack_resource_group" "test" {
+  name     = "acctestRG_%d"
+  location = "%s"
+}
+
+resource "azurestack_dns_zone" "test" {
+  name                = "acctestzone%d.com"
+  resource_group_name = "${azurestack_resource_group.test.name}"
+}
+
+data "azurestack_dns_zone" "test" {
+  name                = "${azurestack_dns_zone.test.name}"
+  resource_group_name = "${azurestack_resource_group.test.name}"
+}
+`, rInt, location, rInt)
+}
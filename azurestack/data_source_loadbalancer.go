@@ -0,0 +1,152 @@
+package azurestack
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func dataSourceArmLoadBalancer() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmLoadBalancerRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"resource_group_name": resourceGroupNameForDataSourceSchema(),
+
+			"location": locationForDataSourceSchema(),
+
+			"sku": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"frontend_ip_configuration": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"subnet_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"private_ip_address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"private_ip_address_allocation": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"public_ip_address_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"load_balancer_rules": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Set:      schema.HashString,
+						},
+
+						"inbound_nat_rules": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Set:      schema.HashString,
+						},
+					},
+				},
+			},
+
+			"private_ip_address": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"private_ip_addresses": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			"tags": tagsForDataSourceSchema(),
+		},
+	}
+}
+
+func dataSourceArmLoadBalancerRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).loadBalancerClient
+	ctx := meta.(*ArmClient).StopContext
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	resp, err := client.Get(ctx, resourceGroup, name, "")
+	if err != nil {
+		if wasNotFound(resp.Response) {
+			return fmt.Errorf("Error: LoadBalancer %q (Resource Group %q) was not found", name, resourceGroup)
+		}
+		return fmt.Errorf("Error making Read request on LoadBalancer %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+	if resp.ID == nil {
+		return fmt.Errorf("Cannot read LoadBalancer %q (Resource Group %q) ID", name, resourceGroup)
+	}
+
+	d.SetId(*resp.ID)
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", resourceGroup)
+	if location := resp.Location; location != nil {
+		d.Set("location", azureStackNormalizeLocation(*location))
+	}
+
+	if sku := resp.Sku; sku != nil {
+		d.Set("sku", string(sku.Name))
+	}
+
+	if props := resp.LoadBalancerPropertiesFormat; props != nil {
+		if feipConfigs := props.FrontendIPConfigurations; feipConfigs != nil {
+			if err := d.Set("frontend_ip_configuration", flattenLoadBalancerFrontendIpConfiguration(feipConfigs)); err != nil {
+				return fmt.Errorf("Error flattening `frontend_ip_configuration`: %+v", err)
+			}
+
+			privateIpAddress := ""
+			privateIpAddresses := make([]string, 0, len(*feipConfigs))
+			for _, config := range *feipConfigs {
+				if feipProps := config.FrontendIPConfigurationPropertiesFormat; feipProps != nil {
+					if ip := feipProps.PrivateIPAddress; ip != nil {
+						if privateIpAddress == "" {
+							privateIpAddress = *feipProps.PrivateIPAddress
+						}
+
+						privateIpAddresses = append(privateIpAddresses, *feipProps.PrivateIPAddress)
+					}
+				}
+			}
+
+			d.Set("private_ip_address", privateIpAddress)
+			d.Set("private_ip_addresses", privateIpAddresses)
+		}
+	}
+
+	flattenAndSetTags(d, &resp.Tags)
+
+	return nil
+}
@@ -0,0 +1,98 @@
+package azurestack
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func dataSourceArmLoadBalancerBackendAddressPool() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmLoadBalancerBackendAddressPoolRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"loadbalancer_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"resource_group_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"backend_ip_configurations": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+
+			"load_balancing_rules": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+		},
+	}
+}
+
+func dataSourceArmLoadBalancerBackendAddressPoolRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).loadBalancerBackendPoolClient
+	ctx := meta.(*ArmClient).StopContext
+
+	name := d.Get("name").(string)
+	loadBalancerID := d.Get("loadbalancer_id").(string)
+
+	resGroup, loadBalancerName, err := resourceGroupAndLBNameFromId(loadBalancerID)
+	if err != nil {
+		return fmt.Errorf("Error Getting LoadBalancer Name and Group: %+v", err)
+	}
+
+	resp, err := client.Get(ctx, resGroup, loadBalancerName, name)
+	if err != nil {
+		if wasNotFound(resp.Response) {
+			return fmt.Errorf("Error: LoadBalancer Backend Address Pool %q (LoadBalancer %q / Resource Group %q) was not found", name, loadBalancerName, resGroup)
+		}
+		return fmt.Errorf("Error making Read request on LoadBalancer Backend Address Pool %q (LoadBalancer %q): %+v", name, loadBalancerName, err)
+	}
+	if resp.ID == nil {
+		return fmt.Errorf("Cannot read LoadBalancer Backend Address Pool %q (LoadBalancer %q) ID", name, loadBalancerName)
+	}
+
+	d.SetId(*resp.ID)
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", resGroup)
+
+	var backendIpConfigurations []string
+	var loadBalancingRules []string
+
+	if props := resp.BackendAddressPoolPropertiesFormat; props != nil {
+		if configs := props.BackendIPConfigurations; configs != nil {
+			for _, backendConfig := range *configs {
+				backendIpConfigurations = append(backendIpConfigurations, *backendConfig.ID)
+			}
+		}
+
+		if rules := props.LoadBalancingRules; rules != nil {
+			for _, rule := range *rules {
+				loadBalancingRules = append(loadBalancingRules, *rule.ID)
+			}
+		}
+	}
+
+	d.Set("backend_ip_configurations", backendIpConfigurations)
+	d.Set("load_balancing_rules", loadBalancingRules)
+
+	return nil
+}
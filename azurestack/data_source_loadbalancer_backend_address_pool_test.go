@@ -0,0 +1,44 @@
+package azurestack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccDataSourceAzureStackLoadBalancerBackendAddressPool_basic(t *testing.T) {
+	dataSourceName := "data.azurestack_lb_backend_address_pool.test"
+	ri := acctest.RandInt()
+	addressPoolName := fmt.Sprintf("%d-address-pool", ri)
+	location := testLocation()
+	config := testAccDataSourceAzureStackLoadBalancerBackendAddressPool_basic(ri, addressPoolName, location)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureStackLoadBalancerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "name", addressPoolName),
+					resource.TestCheckResourceAttrSet(dataSourceName, "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAzureStackLoadBalancerBackendAddressPool_basic(rInt int, addressPoolName string, location string) string {
+	resource := testAccAzureStackLoadBalancerBackEndAddressPool_basic(rInt, addressPoolName, location)
+	return fmt.Sprintf(`
+%s
+
+data "azurestack_lb_backend_address_pool" "test" {
+  name            = "${azurestack_lb_backend_address_pool.test.name}"
+  loadbalancer_id = "${azurestack_lb.test.id}"
+}
+`, resource)
+}
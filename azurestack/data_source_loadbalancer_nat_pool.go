@@ -0,0 +1,114 @@
+package azurestack
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurestack/azurestack/helpers/azure"
+)
+
+func dataSourceArmLoadBalancerNatPool() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmLoadBalancerNatPoolRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"loadbalancer_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"resource_group_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"protocol": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"frontend_port_start": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"frontend_port_end": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"backend_port": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"frontend_ip_configuration_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"frontend_ip_configuration_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceArmLoadBalancerNatPoolRead(d *schema.ResourceData, meta interface{}) error {
+	name := d.Get("name").(string)
+	loadBalancerID := d.Get("loadbalancer_id").(string)
+
+	resGroup, _, err := resourceGroupAndLBNameFromId(loadBalancerID)
+	if err != nil {
+		return fmt.Errorf("Error Getting LoadBalancer Name and Group: %+v", err)
+	}
+
+	loadBalancer, exists, err := retrieveLoadBalancerById(loadBalancerID, meta)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Load Balancer by ID: %+v", err)
+	}
+	if !exists {
+		return fmt.Errorf("Error: LoadBalancer %q was not found", loadBalancerID)
+	}
+
+	config, _, exists := findLoadBalancerNatPoolByName(loadBalancer, name)
+	if !exists {
+		return fmt.Errorf("Error: LoadBalancer NAT Pool %q (LoadBalancer ID %q) was not found", name, loadBalancerID)
+	}
+	if config.ID == nil {
+		return fmt.Errorf("Cannot read LoadBalancer NAT Pool %q (LoadBalancer ID %q) ID", name, loadBalancerID)
+	}
+
+	d.SetId(*config.ID)
+
+	d.Set("name", config.Name)
+	d.Set("resource_group_name", resGroup)
+
+	if props := config.InboundNatPoolPropertiesFormat; props != nil {
+		d.Set("protocol", props.Protocol)
+		d.Set("frontend_port_start", props.FrontendPortRangeStart)
+		d.Set("frontend_port_end", props.FrontendPortRangeEnd)
+		d.Set("backend_port", props.BackendPort)
+
+		if feipConfig := props.FrontendIPConfiguration; feipConfig != nil {
+			fipID, err := parseAzureResourceID(*feipConfig.ID)
+			if err != nil {
+				return err
+			}
+
+			d.Set("frontend_ip_configuration_name", fipID.Path["frontendIPConfigurations"])
+			d.Set("frontend_ip_configuration_id", feipConfig.ID)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,44 @@
+package azurestack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccDataSourceAzureStackLoadBalancerNatPool_basic(t *testing.T) {
+	dataSourceName := "data.azurestack_lb_nat_pool.test"
+	ri := acctest.RandInt()
+	natPoolName := fmt.Sprintf("NatPool-%d", ri)
+	location := testLocation()
+	config := testAccDataSourceAzureStackLoadBalancerNatPool_basic(ri, natPoolName, location)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureStackLoadBalancerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "name", natPoolName),
+					resource.TestCheckResourceAttr(dataSourceName, "protocol", "Tcp"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAzureStackLoadBalancerNatPool_basic(rInt int, natPoolName string, location string) string {
+	resource := testAccAzureStackLoadBalancerNatPool_basic(rInt, natPoolName, location)
+	return fmt.Sprintf(`
+%s
+
+data "azurestack_lb_nat_pool" "test" {
+  name            = "${azurestack_lb_nat_pool.test.name}"
+  loadbalancer_id = "${azurestack_lb.test.id}"
+}
+`, resource)
+}
@@ -0,0 +1,113 @@
+package azurestack
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurestack/azurestack/helpers/azure"
+)
+
+func dataSourceArmLoadBalancerProbe() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmLoadBalancerProbeRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"loadbalancer_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"resource_group_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"protocol": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"port": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"request_path": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"interval_in_seconds": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"number_of_probes": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"load_balancer_rules": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+		},
+	}
+}
+
+func dataSourceArmLoadBalancerProbeRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).loadBalancerProbeClient
+	ctx := meta.(*ArmClient).StopContext
+
+	name := d.Get("name").(string)
+	loadBalancerID := d.Get("loadbalancer_id").(string)
+
+	resGroup, loadBalancerName, err := resourceGroupAndLBNameFromId(loadBalancerID)
+	if err != nil {
+		return fmt.Errorf("Error Getting LoadBalancer Name and Group: %+v", err)
+	}
+
+	resp, err := client.Get(ctx, resGroup, loadBalancerName, name)
+	if err != nil {
+		if wasNotFound(resp.Response) {
+			return fmt.Errorf("Error: LoadBalancer Probe %q (LoadBalancer %q / Resource Group %q) was not found", name, loadBalancerName, resGroup)
+		}
+		return fmt.Errorf("Error making Read request on LoadBalancer Probe %q (LoadBalancer %q): %+v", name, loadBalancerName, err)
+	}
+	if resp.ID == nil {
+		return fmt.Errorf("Cannot read LoadBalancer Probe %q (LoadBalancer %q) ID", name, loadBalancerName)
+	}
+
+	d.SetId(*resp.ID)
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", resGroup)
+
+	if properties := resp.ProbePropertiesFormat; properties != nil {
+		d.Set("protocol", properties.Protocol)
+		d.Set("interval_in_seconds", properties.IntervalInSeconds)
+		d.Set("number_of_probes", properties.NumberOfProbes)
+		d.Set("port", properties.Port)
+		d.Set("request_path", properties.RequestPath)
+
+		var loadBalancerRules []string
+		if rules := properties.LoadBalancingRules; rules != nil {
+			for _, ruleConfig := range *rules {
+				loadBalancerRules = append(loadBalancerRules, *ruleConfig.ID)
+			}
+		}
+		d.Set("load_balancer_rules", loadBalancerRules)
+	}
+
+	return nil
+}
@@ -0,0 +1,44 @@
+package azurestack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccDataSourceAzureStackLoadBalancerProbe_basic(t *testing.T) {
+	dataSourceName := "data.azurestack_lb_probe.test"
+	ri := acctest.RandInt()
+	probeName := fmt.Sprintf("probe-%d", ri)
+	location := testLocation()
+	config := testAccDataSourceAzureStackLoadBalancerProbe_basic(ri, probeName, location)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureStackLoadBalancerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "name", probeName),
+					resource.TestCheckResourceAttr(dataSourceName, "port", "22"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAzureStackLoadBalancerProbe_basic(rInt int, probeName string, location string) string {
+	resource := testAccAzureStackLoadBalancerProbe_basic(rInt, probeName, location)
+	return fmt.Sprintf(`
+%s
+
+data "azurestack_lb_probe" "test" {
+  name            = "${azurestack_lb_probe.test.name}"
+  loadbalancer_id = "${azurestack_lb.test.id}"
+}
+`, resource)
+}
@@ -0,0 +1,148 @@
+package azurestack
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurestack/azurestack/helpers/azure"
+)
+
+func dataSourceArmLoadBalancerRule() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmLoadBalancerRuleRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"loadbalancer_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"resource_group_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"frontend_ip_configuration_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"frontend_ip_configuration_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"backend_address_pool_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"protocol": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"frontend_port": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"backend_port": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"probe_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"enable_floating_ip": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"idle_timeout_in_minutes": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"load_distribution": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"disable_outbound_snat": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceArmLoadBalancerRuleRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).loadBalancerRuleClient
+	ctx := meta.(*ArmClient).StopContext
+
+	name := d.Get("name").(string)
+	loadBalancerID := d.Get("loadbalancer_id").(string)
+
+	resGroup, loadBalancerName, err := resourceGroupAndLBNameFromId(loadBalancerID)
+	if err != nil {
+		return fmt.Errorf("Error Getting LoadBalancer Name and Group: %+v", err)
+	}
+
+	resp, err := client.Get(ctx, resGroup, loadBalancerName, name)
+	if err != nil {
+		if wasNotFound(resp.Response) {
+			return fmt.Errorf("Error: LoadBalancer Rule %q (LoadBalancer %q / Resource Group %q) was not found", name, loadBalancerName, resGroup)
+		}
+		return fmt.Errorf("Error making Read request on LoadBalancer Rule %q (LoadBalancer %q): %+v", name, loadBalancerName, err)
+	}
+	if resp.ID == nil {
+		return fmt.Errorf("Cannot read LoadBalancer Rule %q (LoadBalancer %q) ID", name, loadBalancerName)
+	}
+
+	d.SetId(*resp.ID)
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", resGroup)
+
+	if properties := resp.LoadBalancingRulePropertiesFormat; properties != nil {
+		d.Set("protocol", properties.Protocol)
+		d.Set("frontend_port", properties.FrontendPort)
+		d.Set("backend_port", properties.BackendPort)
+		d.Set("enable_floating_ip", boolValue(properties.EnableFloatingIP))
+		d.Set("idle_timeout_in_minutes", properties.IdleTimeoutInMinutes)
+		d.Set("load_distribution", properties.LoadDistribution)
+		d.Set("disable_outbound_snat", boolValue(properties.DisableOutboundSnat))
+
+		if properties.FrontendIPConfiguration != nil {
+			fipID, err := parseAzureResourceID(*properties.FrontendIPConfiguration.ID)
+			if err != nil {
+				return err
+			}
+
+			d.Set("frontend_ip_configuration_name", fipID.Path["frontendIPConfigurations"])
+			d.Set("frontend_ip_configuration_id", properties.FrontendIPConfiguration.ID)
+		}
+
+		if properties.BackendAddressPool != nil {
+			d.Set("backend_address_pool_id", properties.BackendAddressPool.ID)
+		}
+
+		if properties.Probe != nil {
+			d.Set("probe_id", properties.Probe.ID)
+		}
+	}
+
+	return nil
+}
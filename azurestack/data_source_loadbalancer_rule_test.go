@@ -0,0 +1,46 @@
+package azurestack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccDataSourceAzureStackLoadBalancerRule_basic(t *testing.T) {
+	dataSourceName := "data.azurestack_lb_rule.test"
+	ri := acctest.RandInt()
+	ruleName := fmt.Sprintf("LbRule-%d", ri)
+	location := testLocation()
+	config := testAccDataSourceAzureStackLoadBalancerRule_basic(ri, ruleName, location)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureStackLoadBalancerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "name", ruleName),
+					resource.TestCheckResourceAttr(dataSourceName, "protocol", "Tcp"),
+					resource.TestCheckResourceAttr(dataSourceName, "frontend_port", "3389"),
+					resource.TestCheckResourceAttr(dataSourceName, "backend_port", "3389"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAzureStackLoadBalancerRule_basic(rInt int, ruleName string, location string) string {
+	resource := testAccAzureStackLoadBalancerRule_basic(rInt, ruleName, location)
+	return fmt.Sprintf(`
+%s
+
+data "azurestack_lb_rule" "test" {
+  name            = "${azurestack_lb_rule.test.name}"
+  loadbalancer_id = "${azurestack_lb.test.id}"
+}
+`, resource)
+}
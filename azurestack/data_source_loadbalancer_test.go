@@ -0,0 +1,45 @@
+package azurestack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2017-10-01/network"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccDataSourceAzureStackLoadBalancer_basic(t *testing.T) {
+	dataSourceName := "data.azurestack_lb.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+	config := testAccDataSourceAzureStackLoadBalancer_basic(ri, location)
+
+	var lb network.LoadBalancer
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureStackLoadBalancerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureStackLoadBalancerExists(dataSourceName, &lb),
+					resource.TestCheckResourceAttrSet(dataSourceName, "private_ip_address"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAzureStackLoadBalancer_basic(rInt int, location string) string {
+	resource := testAccAzureStackLoadBalancer_frontEndConfig(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+data "azurestack_lb" "test" {
+  name                = "${azurestack_lb.test.name}"
+  resource_group_name = "${azurestack_lb.test.resource_group_name}"
+}
+`, resource)
+}
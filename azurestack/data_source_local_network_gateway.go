@@ -0,0 +1,107 @@
+package azurestack
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func dataSourceArmLocalNetworkGateway() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmLocalNetworkGatewayRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"resource_group_name": resourceGroupNameForDataSourceSchema(),
+
+			"location": locationForDataSourceSchema(),
+
+			"gateway_address": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"address_space": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			"bgp_settings": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"asn": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+
+						"bgp_peering_address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"peer_weight": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"tags": tagsForDataSourceSchema(),
+		},
+	}
+}
+
+func dataSourceArmLocalNetworkGatewayRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).localNetConnClient
+	ctx := meta.(*ArmClient).StopContext
+
+	name := d.Get("name").(string)
+	resGroup := d.Get("resource_group_name").(string)
+
+	resp, err := client.Get(ctx, resGroup, name)
+	if err != nil {
+		if wasNotFound(resp.Response) {
+			return fmt.Errorf("Error: Local Network Gateway %q (Resource Group %q) was not found", name, resGroup)
+		}
+		return fmt.Errorf("Error reading Local Network Gateway %q (Resource Group %q): %+v", name, resGroup, err)
+	}
+	if resp.ID == nil {
+		return fmt.Errorf("Cannot read Local Network Gateway %q (Resource Group %q) ID", name, resGroup)
+	}
+
+	d.SetId(*resp.ID)
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", resGroup)
+	if location := resp.Location; location != nil {
+		d.Set("location", azureStackNormalizeLocation(*location))
+	}
+
+	if props := resp.LocalNetworkGatewayPropertiesFormat; props != nil {
+		d.Set("gateway_address", props.GatewayIPAddress)
+
+		if lnas := props.LocalNetworkAddressSpace; lnas != nil {
+			if prefixes := lnas.AddressPrefixes; prefixes != nil {
+				d.Set("address_space", *prefixes)
+			}
+		}
+
+		if err := d.Set("bgp_settings", flattenLocalNetworkGatewayBGPSettings(props.BgpSettings)); err != nil {
+			return err
+		}
+	}
+
+	flattenAndSetTags(d, &resp.Tags)
+
+	return nil
+}
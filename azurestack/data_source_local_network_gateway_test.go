@@ -0,0 +1,54 @@
+package azurestack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccDataAzureStackLocalNetworkGateway_basic(t *testing.T) {
+	dataSourceName := "data.azurestack_local_network_gateway.test"
+	ri := acctest.RandInt()
+
+	config := testAccDataAzureStackLocalNetworkGatewayBasic(ri, testLocation())
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "gateway_address", "168.62.225.23"),
+					resource.TestCheckResourceAttr(dataSourceName, "address_space.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "address_space.0", "10.1.1.0/24"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataAzureStackLocalNetworkGatewayBasic(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurestack_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurestack_local_network_gateway" "test" {
+  name                = "acctest-%d"
+  location            = "${azurestack_resource_group.test.location}"
+  resource_group_name = "${azurestack_resource_group.test.name}"
+
+  gateway_address = "168.62.225.23"
+  address_space   = ["10.1.1.0/24"]
+}
+
+data "azurestack_local_network_gateway" "test" {
+  name                = "${azurestack_local_network_gateway.test.name}"
+  resource_group_name = "${azurestack_resource_group.test.name}"
+}
+`, rInt, location, rInt)
+}
@@ -2,10 +2,10 @@ package azurestack
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func dataSourceArmPublicIP() *schema.Resource {
@@ -25,6 +25,11 @@ func dataSourceArmPublicIP() *schema.Resource {
 				Computed: true,
 			},
 
+			"public_ip_address_allocation": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
 			"idle_timeout_in_minutes": {
 				Type:     schema.TypeInt,
 				Computed: true,
@@ -54,7 +59,7 @@ func dataSourceArmPublicIPRead(d *schema.ResourceData, meta interface{}) error {
 
 	resp, err := client.Get(ctx, resGroup, name, "")
 	if err != nil {
-		if utils.ResponseWasNotFound(resp.Response) {
+		if wasNotFound(resp.Response) {
 			return fmt.Errorf("Error: Public IP %q (Resource Group %q) was not found", name, resGroup)
 		}
 		return fmt.Errorf("Error making Read request on Azure public ip %s: %s", name, err)
@@ -63,6 +68,8 @@ func dataSourceArmPublicIPRead(d *schema.ResourceData, meta interface{}) error {
 	d.SetId(*resp.ID)
 
 	if props := resp.PublicIPAddressPropertiesFormat; props != nil {
+		d.Set("public_ip_address_allocation", strings.ToLower(string(props.PublicIPAllocationMethod)))
+
 		if dnsSettings := props.DNSSettings; dnsSettings != nil {
 			if v := dnsSettings.Fqdn; v != nil && *v != "" {
 				d.Set("fqdn", v)
@@ -6,7 +6,6 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2017-10-01/network"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func dataSourceArmRouteTable() *schema.Resource {
@@ -73,7 +72,7 @@ func dataSourceArmRouteTableRead(d *schema.ResourceData, meta interface{}) error
 
 	resp, err := client.Get(ctx, resourceGroup, name, "")
 	if err != nil {
-		if utils.ResponseWasNotFound(resp.Response) {
+		if wasNotFound(resp.Response) {
 			return fmt.Errorf("Error: Route Table %q (Resource Group %q) was not found", name, resourceGroup)
 		}
 		return fmt.Errorf("Error making Read request on Azure Route Table %q: %+v", name, err)
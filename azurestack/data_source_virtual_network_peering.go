@@ -0,0 +1,99 @@
+package azurestack
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func dataSourceArmVirtualNetworkPeering() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmVirtualNetworkPeeringRead,
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"resource_group_name": resourceGroupNameForDataSourceSchema(),
+
+			"virtual_network_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"remote_virtual_network_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"peering_state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"allow_virtual_network_access": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"allow_forwarded_traffic": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"allow_gateway_transit": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"use_remote_gateways": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceArmVirtualNetworkPeeringRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).vnetPeeringClient
+	ctx := meta.(*ArmClient).StopContext
+
+	resGroup := d.Get("resource_group_name").(string)
+	vnetName := d.Get("virtual_network_name").(string)
+	name := d.Get("name").(string)
+
+	resp, err := client.Get(ctx, resGroup, vnetName, name)
+	if err != nil {
+		if wasNotFound(resp.Response) {
+			return fmt.Errorf("Error: Virtual Network Peering %q (Virtual Network %q / Resource Group %q) was not found", name, vnetName, resGroup)
+		}
+		return fmt.Errorf("Error reading Virtual Network Peering %q (Virtual Network %q / Resource Group %q): %+v", name, vnetName, resGroup, err)
+	}
+	if resp.ID == nil {
+		return fmt.Errorf("Cannot read Virtual Network Peering %q (Virtual Network %q / Resource Group %q) ID", name, vnetName, resGroup)
+	}
+
+	d.SetId(*resp.ID)
+
+	d.Set("name", name)
+	d.Set("resource_group_name", resGroup)
+	d.Set("virtual_network_name", vnetName)
+
+	if props := resp.VirtualNetworkPeeringPropertiesFormat; props != nil {
+		if remote := props.RemoteVirtualNetwork; remote != nil {
+			d.Set("remote_virtual_network_id", remote.ID)
+		}
+
+		d.Set("peering_state", string(props.PeeringState))
+		d.Set("allow_virtual_network_access", props.AllowVirtualNetworkAccess)
+		d.Set("allow_forwarded_traffic", props.AllowForwardedTraffic)
+		d.Set("allow_gateway_transit", props.AllowGatewayTransit)
+		d.Set("use_remote_gateways", props.UseRemoteGateways)
+	}
+
+	return nil
+}
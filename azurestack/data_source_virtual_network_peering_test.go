@@ -0,0 +1,67 @@
+package azurestack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccDataAzureStackVirtualNetworkPeering_basic(t *testing.T) {
+	dataSourceName := "data.azurestack_virtual_network_peering.test"
+	ri := acctest.RandInt()
+
+	config := testAccDataAzureStackVirtualNetworkPeeringBasic(ri, testLocation())
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "remote_virtual_network_id"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "peering_state"),
+					resource.TestCheckResourceAttr(dataSourceName, "allow_virtual_network_access", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataAzureStackVirtualNetworkPeeringBasic(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurestack_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurestack_virtual_network" "test1" {
+  name                = "acctestvnet1-%d"
+  address_space       = ["10.0.0.0/16"]
+  location            = "${azurestack_resource_group.test.location}"
+  resource_group_name = "${azurestack_resource_group.test.name}"
+}
+
+resource "azurestack_virtual_network" "test2" {
+  name                = "acctestvnet2-%d"
+  address_space       = ["10.1.0.0/16"]
+  location            = "${azurestack_resource_group.test.location}"
+  resource_group_name = "${azurestack_resource_group.test.name}"
+}
+
+resource "azurestack_virtual_network_peering" "test" {
+  name                      = "acctestpeer-%d"
+  resource_group_name       = "${azurestack_resource_group.test.name}"
+  virtual_network_name      = "${azurestack_virtual_network.test1.name}"
+  remote_virtual_network_id = "${azurestack_virtual_network.test2.id}"
+}
+
+data "azurestack_virtual_network_peering" "test" {
+  name                 = "${azurestack_virtual_network_peering.test.name}"
+  resource_group_name  = "${azurestack_resource_group.test.name}"
+  virtual_network_name = "${azurestack_virtual_network.test1.name}"
+}
+`, rInt, location, rInt, rInt, rInt)
+}
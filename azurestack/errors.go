@@ -0,0 +1,27 @@
+package azurestack
+
+import (
+	"fmt"
+
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+// armErrorDetail formats err for inclusion in a resource's own error message. When err is a
+// *azure.RequestError - the case for most failures returned by the generated ARM clients - the
+// service's own error code, message and `x-ms-request-id` are surfaced directly, since those are
+// what's actually needed to triage a failed apply against a support ticket rather than a
+// generic Go-formatted error. Any other error is formatted the same way the rest of this
+// package already does.
+func armErrorDetail(err error) string {
+	requestErr, ok := err.(*azure.RequestError)
+	if !ok || requestErr.ServiceError == nil {
+		return fmt.Sprintf("%+v", err)
+	}
+
+	detail := fmt.Sprintf("%s: %s", requestErr.ServiceError.Code, requestErr.ServiceError.Message)
+	if requestErr.RequestID != "" {
+		detail += fmt.Sprintf(" (Request ID: %s)", requestErr.RequestID)
+	}
+
+	return detail
+}
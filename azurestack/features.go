@@ -0,0 +1,88 @@
+package azurestack
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// Features controls the deletion-safety behaviors of resources which have destructive side
+// effects by default (e.g. deleting a Resource Group which still contains resources, or leaving
+// an Azure Stack Managed Disk behind when its Virtual Machine is deleted).
+type Features struct {
+	VirtualMachine VirtualMachineFeatures
+	ResourceGroup  ResourceGroupFeatures
+}
+
+type VirtualMachineFeatures struct {
+	DeleteOSDiskOnDeletion bool
+}
+
+type ResourceGroupFeatures struct {
+	PreventDeletionIfContainsResources bool
+}
+
+// schemaFeatures returns the Provider-level `features` block used to control the deletion-safety
+// behaviors above.
+func schemaFeatures() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"virtual_machine": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"delete_os_disk_on_deletion": {
+								Type:     schema.TypeBool,
+								Optional: true,
+								Default:  false,
+							},
+						},
+					},
+				},
+
+				"resource_group": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"prevent_deletion_if_contains_resources": {
+								Type:     schema.TypeBool,
+								Optional: true,
+								Default:  false,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// expandFeatures parses the `features` block into a Features value, defaulting every toggle to
+// `false` (the Provider's pre-existing behavior) when the block isn't supplied.
+func expandFeatures(input []interface{}) Features {
+	features := Features{}
+
+	if len(input) == 0 || input[0] == nil {
+		return features
+	}
+
+	val := input[0].(map[string]interface{})
+
+	if raw, ok := val["virtual_machine"].([]interface{}); ok && len(raw) > 0 && raw[0] != nil {
+		vm := raw[0].(map[string]interface{})
+		features.VirtualMachine.DeleteOSDiskOnDeletion = vm["delete_os_disk_on_deletion"].(bool)
+	}
+
+	if raw, ok := val["resource_group"].([]interface{}); ok && len(raw) > 0 && raw[0] != nil {
+		rg := raw[0].(map[string]interface{})
+		features.ResourceGroup.PreventDeletionIfContainsResources = rg["prevent_deletion_if_contains_resources"].(bool)
+	}
+
+	return features
+}
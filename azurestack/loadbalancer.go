@@ -147,6 +147,124 @@ func validateLoadBalancerPrivateIpAddressAllocation(v interface{}, k string) (ws
 	return
 }
 
+// loadBalancerFrontendPort describes the range of external ports claimed by a NAT Pool or NAT Rule
+// on a particular frontend IP configuration, for comparison against another sub-resource's range.
+type loadBalancerFrontendPort struct {
+	name  string
+	start int32
+	end   int32
+}
+
+// customizeDiffLoadBalancerFrontendPortCollision returns a CustomizeDiffFunc which checks the
+// resource's own frontend port range (frontend_port_start/frontend_port_end for NAT Pools, or
+// frontend_port for NAT Rules) against every other NAT Pool and NAT Rule already provisioned on the
+// same LoadBalancer frontend IP configuration, and errors at plan time if any of them overlap - ARM
+// only rejects an overlapping range at apply time, with an error that doesn't name the conflicting
+// sub-resource. This can only see sub-resources the service already knows about, so it won't catch a
+// collision between two sub-resources created in the same apply.
+func customizeDiffLoadBalancerFrontendPortCollision(diff *schema.ResourceDiff, meta interface{}) error {
+	loadBalancerID, ok := diff.GetOk("loadbalancer_id")
+	if !ok {
+		return nil
+	}
+
+	frontendConfigName, ok := diff.GetOk("frontend_ip_configuration_name")
+	if !ok {
+		return nil
+	}
+
+	loadBalancer, exists, err := retrieveLoadBalancerById(loadBalancerID.(string), meta)
+	if err != nil || !exists || loadBalancer.LoadBalancerPropertiesFormat == nil {
+		// nothing to compare against yet - most likely the LoadBalancer is being created in this
+		// same apply
+		return nil
+	}
+
+	name := diff.Get("name").(string)
+	start, end := loadBalancerDiffFrontendPortRange(diff)
+
+	var siblings []loadBalancerFrontendPort
+	if pools := loadBalancer.LoadBalancerPropertiesFormat.InboundNatPools; pools != nil {
+		for _, pool := range *pools {
+			if port, ok := loadBalancerNatPoolFrontendPort(pool, frontendConfigName.(string)); ok && port.name != name {
+				siblings = append(siblings, port)
+			}
+		}
+	}
+	if rules := loadBalancer.LoadBalancerPropertiesFormat.InboundNatRules; rules != nil {
+		for _, rule := range *rules {
+			if port, ok := loadBalancerNatRuleFrontendPort(rule, frontendConfigName.(string)); ok && port.name != name {
+				siblings = append(siblings, port)
+			}
+		}
+	}
+
+	for _, sibling := range siblings {
+		if start <= sibling.end && sibling.start <= end {
+			return fmt.Errorf("frontend port range %d-%d on frontend IP configuration %q overlaps with %q (%d-%d)", start, end, frontendConfigName, sibling.name, sibling.start, sibling.end)
+		}
+	}
+
+	return nil
+}
+
+// loadBalancerDiffFrontendPortRange returns the frontend port range claimed by the sub-resource
+// being diffed - either frontend_port_start/frontend_port_end (NAT Pool) or frontend_port (NAT
+// Rule, as a single-port range).
+func loadBalancerDiffFrontendPortRange(diff *schema.ResourceDiff) (int32, int32) {
+	if v, ok := diff.GetOk("frontend_port_start"); ok {
+		return int32(v.(int)), int32(diff.Get("frontend_port_end").(int))
+	}
+
+	port := int32(diff.Get("frontend_port").(int))
+	return port, port
+}
+
+func loadBalancerNatPoolFrontendPort(pool network.InboundNatPool, frontendConfigName string) (loadBalancerFrontendPort, bool) {
+	if pool.Name == nil || pool.InboundNatPoolPropertiesFormat == nil {
+		return loadBalancerFrontendPort{}, false
+	}
+
+	props := pool.InboundNatPoolPropertiesFormat
+	if !loadBalancerFrontendConfigNameMatches(props.FrontendIPConfiguration, frontendConfigName) {
+		return loadBalancerFrontendPort{}, false
+	}
+	if props.FrontendPortRangeStart == nil || props.FrontendPortRangeEnd == nil {
+		return loadBalancerFrontendPort{}, false
+	}
+
+	return loadBalancerFrontendPort{name: *pool.Name, start: *props.FrontendPortRangeStart, end: *props.FrontendPortRangeEnd}, true
+}
+
+func loadBalancerNatRuleFrontendPort(rule network.InboundNatRule, frontendConfigName string) (loadBalancerFrontendPort, bool) {
+	if rule.Name == nil || rule.InboundNatRulePropertiesFormat == nil {
+		return loadBalancerFrontendPort{}, false
+	}
+
+	props := rule.InboundNatRulePropertiesFormat
+	if !loadBalancerFrontendConfigNameMatches(props.FrontendIPConfiguration, frontendConfigName) {
+		return loadBalancerFrontendPort{}, false
+	}
+	if props.FrontendPort == nil {
+		return loadBalancerFrontendPort{}, false
+	}
+
+	return loadBalancerFrontendPort{name: *rule.Name, start: *props.FrontendPort, end: *props.FrontendPort}, true
+}
+
+func loadBalancerFrontendConfigNameMatches(frontendIPConfiguration *network.SubResource, name string) bool {
+	if frontendIPConfiguration == nil || frontendIPConfiguration.ID == nil {
+		return false
+	}
+
+	fipID, err := parseAzureResourceID(*frontendIPConfiguration.ID)
+	if err != nil {
+		return false
+	}
+
+	return fipID.Path["frontendIPConfigurations"] == name
+}
+
 // sets the loadbalancer_id in the ResourceData from the sub resources full id
 func loadBalancerSubResourceStateImporter(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	r, err := regexp.Compile(`.+\/loadBalancers\/.+?\/`)
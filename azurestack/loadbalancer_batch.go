@@ -0,0 +1,199 @@
+package azurestack
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2017-10-01/network"
+)
+
+// lbWriteConflictRetries bounds how many times flushLoadBalancerWriteBatch will re-read the
+// LoadBalancer, replay its queued mutations against the fresh copy and resubmit after losing an
+// If-Match race - to another Terraform pipeline, a portal edit, or another batch that snuck in
+// between this batch's own GET and PUT.
+const lbWriteConflictRetries = 3
+
+// lbCoalesceWindow bounds how long the first sub-resource write to a given LoadBalancer waits for
+// other concurrent writes to the same LoadBalancer to join it before flushing every queued
+// mutation as a single GET + CreateOrUpdate + wait. Terraform applies independent resources (for
+// example several azurestack_lb_rule blocks pointing at the same azurestack_lb) in parallel, so
+// without this each one serializes behind armMutexKV and performs its own full-LoadBalancer PUT -
+// on a stamp where that PUT takes minutes, N sub-resources means N times that wait.
+const lbCoalesceWindow = 250 * time.Millisecond
+
+// lbWriteBatch accumulates the mutations queued by every sub-resource write which joined it before
+// its leader flushed them as a single LoadBalancer update.
+type lbWriteBatch struct {
+	writes []func(*network.LoadBalancer) error
+	done   chan struct{}
+	result *network.LoadBalancer
+	err    error
+}
+
+var (
+	lbWritesMu sync.Mutex
+	lbWrites   = make(map[string]*lbWriteBatch)
+)
+
+// loadBalancerBatchClient is the seam flushLoadBalancerWriteBatch reads and writes the
+// LoadBalancer through, so that the batching/coalescing/conflict-retry logic in this file can be
+// exercised in a unit test against a fake, rather than only ever running against the live SDK
+// client every acceptance test creates resources through one at a time.
+type loadBalancerBatchClient interface {
+	read(loadBalancerID string) (*network.LoadBalancer, bool, error)
+	write(ctx context.Context, resGroup, loadBalancerName string, loadBalancer *network.LoadBalancer) (network.LoadBalancer, error)
+}
+
+// armLoadBalancerBatchClient is loadBalancerBatchClient's live implementation, backed by the
+// ArmClient's LoadBalancersClient.
+type armLoadBalancerBatchClient struct {
+	meta interface{}
+}
+
+func (c armLoadBalancerBatchClient) read(loadBalancerID string) (*network.LoadBalancer, bool, error) {
+	return retrieveLoadBalancerById(loadBalancerID, c.meta)
+}
+
+func (c armLoadBalancerBatchClient) write(ctx context.Context, resGroup, loadBalancerName string, loadBalancer *network.LoadBalancer) (network.LoadBalancer, error) {
+	client := c.meta.(*ArmClient).loadBalancerClient
+	return updateLoadBalancerWithETag(ctx, client, resGroup, loadBalancerName, loadBalancer)
+}
+
+// applyLoadBalancerWrite queues mutate to run against the LoadBalancer identified by
+// loadBalancerID, coalesced with any other writes queued for the same LoadBalancer within
+// lbCoalesceWindow, and blocks until the batch it joined has flushed. All writes in a batch
+// succeed or fail together - if any queued mutate returns an error, every caller in that batch
+// receives it, since by that point their changes may already be interleaved into the same
+// in-memory LoadBalancer object. The returned LoadBalancer is the flush's own read-back GET, so a
+// caller can locate its own sub-resource's ID without a further round trip.
+func applyLoadBalancerWrite(ctx context.Context, meta interface{}, loadBalancerID string, mutate func(*network.LoadBalancer) error) (*network.LoadBalancer, error) {
+	return applyLoadBalancerWriteWithClient(ctx, armLoadBalancerBatchClient{meta: meta}, loadBalancerID, mutate)
+}
+
+func applyLoadBalancerWriteWithClient(ctx context.Context, client loadBalancerBatchClient, loadBalancerID string, mutate func(*network.LoadBalancer) error) (*network.LoadBalancer, error) {
+	lbWritesMu.Lock()
+	batch, exists := lbWrites[loadBalancerID]
+	if !exists {
+		batch = &lbWriteBatch{done: make(chan struct{})}
+		lbWrites[loadBalancerID] = batch
+	}
+	batch.writes = append(batch.writes, mutate)
+	lbWritesMu.Unlock()
+
+	if !exists {
+		// this goroutine created the batch, so it's responsible for flushing it: wait for other
+		// concurrent writers to the same LoadBalancer to join, then remove the batch (so a write
+		// arriving after this point starts a fresh one rather than joining a batch that's already
+		// being flushed) and apply everything queued so far as a single LoadBalancer update.
+		time.Sleep(lbCoalesceWindow)
+
+		lbWritesMu.Lock()
+		delete(lbWrites, loadBalancerID)
+		lbWritesMu.Unlock()
+
+		// close(batch.done) and the panic recovery are both deferred so that every joiner waiting
+		// on batch.done is released - with an error, rather than hanging forever - even if
+		// flushLoadBalancerWriteBatch or one of the queued mutate funcs panics.
+		func() {
+			defer close(batch.done)
+			defer func() {
+				if r := recover(); r != nil {
+					batch.result = nil
+					batch.err = fmt.Errorf("Error Creating/Updating LoadBalancer %q: panic while flushing write batch: %v", loadBalancerID, r)
+				}
+			}()
+			batch.result, batch.err = flushLoadBalancerWriteBatch(ctx, client, loadBalancerID, batch.writes)
+		}()
+	}
+
+	<-batch.done
+	return batch.result, batch.err
+}
+
+func flushLoadBalancerWriteBatch(ctx context.Context, client loadBalancerBatchClient, loadBalancerID string, writes []func(*network.LoadBalancer) error) (*network.LoadBalancer, error) {
+	armMutexKV.Lock(loadBalancerID)
+	defer armMutexKV.Unlock(loadBalancerID)
+
+	resGroup, loadBalancerName, err := resourceGroupAndLBNameFromId(loadBalancerID)
+	if err != nil {
+		return nil, fmt.Errorf("Error Getting LoadBalancer Name and Group: %+v", err)
+	}
+
+	var read network.LoadBalancer
+	for attempt := 0; ; attempt++ {
+		loadBalancer, exists, err := client.read(loadBalancerID)
+		if err != nil {
+			return nil, fmt.Errorf("Error Getting LoadBalancer By ID: %+v", err)
+		}
+		if !exists {
+			return nil, fmt.Errorf("LoadBalancer %q was not found", loadBalancerID)
+		}
+
+		for _, mutate := range writes {
+			if err := mutate(loadBalancer); err != nil {
+				return nil, err
+			}
+		}
+
+		read, err = client.write(ctx, resGroup, loadBalancerName, loadBalancer)
+		if err == errLoadBalancerPreconditionFailed {
+			if attempt >= lbWriteConflictRetries {
+				return nil, fmt.Errorf("Error Creating/Updating LoadBalancer %q (Resource Group %q): the LoadBalancer kept changing underneath this write after %d attempts", loadBalancerName, resGroup, attempt+1)
+			}
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("Error Creating/Updating LoadBalancer %q (Resource Group %q): %+v", loadBalancerName, resGroup, err)
+		}
+
+		break
+	}
+
+	return &read, nil
+}
+
+// errLoadBalancerPreconditionFailed is returned by updateLoadBalancerWithETag when the service
+// rejects a CreateOrUpdate because the LoadBalancer's ETag no longer matches - i.e. someone else
+// (another pipeline, a portal edit) changed it since it was last read.
+var errLoadBalancerPreconditionFailed = fmt.Errorf("LoadBalancer was modified since it was last read")
+
+// updateLoadBalancerWithETag submits loadBalancer with an If-Match header set to its own Etag, so
+// the service rejects the write with a Precondition Failed rather than silently clobbering a
+// concurrent change to the same LoadBalancer that this copy doesn't reflect. The generated
+// LoadBalancersClient.CreateOrUpdate has no parameter for extra headers, so this drives its
+// exported Preparer/Sender pair directly rather than the single all-in-one method the rest of the
+// provider calls.
+func updateLoadBalancerWithETag(ctx context.Context, client network.LoadBalancersClient, resGroup, loadBalancerName string, loadBalancer *network.LoadBalancer) (network.LoadBalancer, error) {
+	req, err := client.CreateOrUpdatePreparer(ctx, resGroup, loadBalancerName, *loadBalancer)
+	if err != nil {
+		return network.LoadBalancer{}, fmt.Errorf("Error preparing LoadBalancer update: %+v", err)
+	}
+	if loadBalancer.Etag != nil {
+		req.Header.Set("If-Match", *loadBalancer.Etag)
+	}
+
+	future, err := client.CreateOrUpdateSender(req)
+	if err != nil {
+		if resp := future.Response(); resp != nil && resp.StatusCode == http.StatusPreconditionFailed {
+			return network.LoadBalancer{}, errLoadBalancerPreconditionFailed
+		}
+		return network.LoadBalancer{}, err
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return network.LoadBalancer{}, fmt.Errorf("Error waiting for completion of LoadBalancer %q (Resource Group %q): %+v", loadBalancerName, resGroup, err)
+	}
+
+	read, err := client.Get(ctx, resGroup, loadBalancerName, "")
+	if err != nil {
+		return network.LoadBalancer{}, fmt.Errorf("Error retrieving LoadBalancer %q (Resource Group %q): %+v", loadBalancerName, resGroup, err)
+	}
+	if read.ID == nil {
+		return network.LoadBalancer{}, fmt.Errorf("Cannot read LoadBalancer %q (Resource Group %q) ID", loadBalancerName, resGroup)
+	}
+
+	return read, nil
+}
@@ -0,0 +1,230 @@
+package azurestack
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2017-10-01/network"
+)
+
+const testLoadBalancerBatchID = "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/test-rg/providers/Microsoft.Network/loadBalancers/test-lb"
+
+// fakeLoadBalancerBatchClient is a loadBalancerBatchClient backed by an in-memory LoadBalancer
+// instead of the live SDK, so applyLoadBalancerWriteWithClient's coalescing/locking logic can be
+// driven by real concurrent goroutines without a network call in sight.
+type fakeLoadBalancerBatchClient struct {
+	mu sync.Mutex
+
+	loadBalancer network.LoadBalancer
+	writeCount   int
+
+	// failWritesBeforePreconditionOK, if positive, makes that many writes fail with
+	// errLoadBalancerPreconditionFailed before a write is allowed to succeed.
+	failWritesBeforePreconditionOK int
+}
+
+func (f *fakeLoadBalancerBatchClient) read(loadBalancerID string) (*network.LoadBalancer, bool, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	// return a copy, mirroring the live client returning a fresh GET each time round the retry loop
+	lb := f.loadBalancer
+	return &lb, true, nil
+}
+
+func (f *fakeLoadBalancerBatchClient) write(ctx context.Context, resGroup, loadBalancerName string, loadBalancer *network.LoadBalancer) (network.LoadBalancer, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.writeCount++
+
+	if f.failWritesBeforePreconditionOK > 0 {
+		f.failWritesBeforePreconditionOK--
+		return network.LoadBalancer{}, errLoadBalancerPreconditionFailed
+	}
+
+	f.loadBalancer = *loadBalancer
+	return f.loadBalancer, nil
+}
+
+// appendRuleName is a mutate func that appends name to the LoadBalancer's LoadBalancingRules, so
+// tests can assert every queued mutation landed and in what order.
+func appendRuleName(name string) func(*network.LoadBalancer) error {
+	return func(lb *network.LoadBalancer) error {
+		if lb.LoadBalancerPropertiesFormat == nil {
+			lb.LoadBalancerPropertiesFormat = &network.LoadBalancerPropertiesFormat{}
+		}
+
+		existing := []network.LoadBalancingRule{}
+		if lb.LoadBalancingRules != nil {
+			existing = *lb.LoadBalancingRules
+		}
+		existing = append(existing, network.LoadBalancingRule{Name: &name})
+		lb.LoadBalancingRules = &existing
+
+		return nil
+	}
+}
+
+func ruleNames(lb *network.LoadBalancer) []string {
+	if lb == nil || lb.LoadBalancerPropertiesFormat == nil || lb.LoadBalancingRules == nil {
+		return nil
+	}
+
+	names := make([]string, 0, len(*lb.LoadBalancingRules))
+	for _, rule := range *lb.LoadBalancingRules {
+		names = append(names, *rule.Name)
+	}
+	return names
+}
+
+// waitOrTimeout blocks until wg is done or 5 seconds pass, failing the test in the latter case
+// instead of letting a hang in the code under test time out the whole test binary.
+func waitOrTimeout(t *testing.T, wg *sync.WaitGroup, msg string) {
+	t.Helper()
+
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal(msg)
+	}
+}
+
+func TestApplyLoadBalancerWriteWithClient_coalescesConcurrentJoins(t *testing.T) {
+	client := &fakeLoadBalancerBatchClient{}
+
+	const writers = 10
+	var wg sync.WaitGroup
+	results := make([]*network.LoadBalancer, writers)
+	errs := make([]error, writers)
+
+	for i := 0; i < writers; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			results[i], errs[i] = applyLoadBalancerWriteWithClient(context.Background(), client, testLoadBalancerBatchID, appendRuleName(fmt.Sprintf("rule-%d", i)))
+		}(i)
+	}
+	waitOrTimeout(t, &wg, "concurrent writers never returned")
+
+	for i, err := range errs {
+		if err != nil {
+			t.Fatalf("writer %d: unexpected error: %+v", i, err)
+		}
+	}
+
+	if client.writeCount != 1 {
+		t.Fatalf("expected all %d concurrent writers to coalesce into a single flush, got %d writes", writers, client.writeCount)
+	}
+
+	if got := len(ruleNames(results[0])); got != writers {
+		t.Fatalf("expected the flushed LoadBalancer to carry all %d queued rules, got %d: %v", writers, got, ruleNames(results[0]))
+	}
+
+	// every joiner gets back the same flush result
+	for i := 1; i < writers; i++ {
+		if len(ruleNames(results[i])) != len(ruleNames(results[0])) {
+			t.Fatalf("writer %d got a different result than writer 0: %v vs %v", i, ruleNames(results[i]), ruleNames(results[0]))
+		}
+	}
+}
+
+func TestApplyLoadBalancerWriteWithClient_sharedErrorPropagates(t *testing.T) {
+	client := &fakeLoadBalancerBatchClient{}
+
+	failure := fmt.Errorf("boom")
+	failingMutate := func(lb *network.LoadBalancer) error {
+		return failure
+	}
+
+	const writers = 5
+	var wg sync.WaitGroup
+	errs := make([]error, writers)
+
+	for i := 0; i < writers; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			mutate := appendRuleName(fmt.Sprintf("rule-%d", i))
+			if i == writers/2 {
+				mutate = failingMutate
+			}
+			_, errs[i] = applyLoadBalancerWriteWithClient(context.Background(), client, testLoadBalancerBatchID, mutate)
+		}(i)
+	}
+	waitOrTimeout(t, &wg, "concurrent writers never returned")
+
+	for i, err := range errs {
+		if err != failure {
+			t.Fatalf("writer %d: expected the shared batch error %v, got %v", i, failure, err)
+		}
+	}
+
+	if client.writeCount != 0 {
+		t.Fatalf("expected a batch with a failing mutate to never reach the write, got %d writes", client.writeCount)
+	}
+}
+
+func TestApplyLoadBalancerWriteWithClient_retriesOnPreconditionFailed(t *testing.T) {
+	client := &fakeLoadBalancerBatchClient{failWritesBeforePreconditionOK: lbWriteConflictRetries}
+
+	result, err := applyLoadBalancerWriteWithClient(context.Background(), client, testLoadBalancerBatchID, appendRuleName("rule-0"))
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	if client.writeCount != lbWriteConflictRetries+1 {
+		t.Fatalf("expected %d writes (the failures plus the final success), got %d", lbWriteConflictRetries+1, client.writeCount)
+	}
+	if got := ruleNames(result); len(got) != 1 || got[0] != "rule-0" {
+		t.Fatalf("expected the retried write to still carry the queued mutation, got %v", got)
+	}
+}
+
+func TestApplyLoadBalancerWriteWithClient_exhaustingRetriesReturnsError(t *testing.T) {
+	client := &fakeLoadBalancerBatchClient{failWritesBeforePreconditionOK: lbWriteConflictRetries + 1}
+
+	_, err := applyLoadBalancerWriteWithClient(context.Background(), client, testLoadBalancerBatchID, appendRuleName("rule-0"))
+	if err == nil {
+		t.Fatal("expected an error once the conflict retries are exhausted")
+	}
+}
+
+func TestApplyLoadBalancerWriteWithClient_panicInMutateDoesNotHangJoiners(t *testing.T) {
+	client := &fakeLoadBalancerBatchClient{}
+
+	panickingMutate := func(lb *network.LoadBalancer) error {
+		panic("boom")
+	}
+
+	const writers = 3
+	var wg sync.WaitGroup
+	errs := make([]error, writers)
+
+	for i := 0; i < writers; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			mutate := appendRuleName(fmt.Sprintf("rule-%d", i))
+			if i == 0 {
+				mutate = panickingMutate
+			}
+			_, errs[i] = applyLoadBalancerWriteWithClient(context.Background(), client, testLoadBalancerBatchID, mutate)
+		}(i)
+	}
+	waitOrTimeout(t, &wg, "joiners never returned - a panic in the leader's flush left batch.done unclosed")
+
+	for i, err := range errs {
+		if err == nil {
+			t.Fatalf("writer %d: expected the panic to surface as a shared error, got nil", i)
+		}
+	}
+}
@@ -1,6 +1,7 @@
 package azurestack
 
 import (
+	"fmt"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
@@ -35,3 +36,22 @@ func azureStackNormalizeLocation(location interface{}) string {
 func azureStackSuppressLocationDiff(k, old, new string, d *schema.ResourceData) bool {
 	return azureStackNormalizeLocation(old) == azureStackNormalizeLocation(new)
 }
+
+// customizeDiffValidateLocation is a CustomizeDiffFunc which checks `location` against the
+// stamp's own advertised locations (gathered from the Resource Providers list fetched during
+// Configure), so a typo'd or unavailable location is caught at plan time rather than as a late
+// ARM error. A no-op when validLocations wasn't populated, i.e. when `skip_credentials_validation`
+// is set.
+func customizeDiffValidateLocation(diff *schema.ResourceDiff, meta interface{}) error {
+	validLocations := meta.(*ArmClient).validLocations
+	if len(validLocations) == 0 {
+		return nil
+	}
+
+	location := diff.Get("location").(string)
+	if _, ok := validLocations[azureStackNormalizeLocation(location)]; !ok {
+		return fmt.Errorf("`location` %q is not one of the locations available on this Azure Stack Hub stamp", location)
+	}
+
+	return nil
+}
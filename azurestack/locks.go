@@ -1,24 +1,100 @@
 package azurestack
 
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+)
+
+// lockTimeout bounds how long azureStackLockByName/azureStackLockMultipleByName will wait to
+// acquire a lock before giving up, so a plan which would otherwise deadlock behind another
+// operation holding the same lock (for example a stuck deletion of a parent Virtual Network)
+// fails with an actionable error instead of hanging indefinitely.
+const lockTimeout = 20 * time.Minute
+
+// lockManager is a key/value store of semaphores, one per key, acquired and released via channel
+// sends/receives rather than a sync.Mutex - so that a caller which gives up waiting on a lock can
+// simply stop selecting on it, instead of a background goroutine being left holding the lock
+// forever with nothing left to release it.
+type lockManager struct {
+	mu    sync.Mutex
+	locks map[string]chan struct{}
+}
+
+func newLockManager() *lockManager {
+	return &lockManager{locks: make(map[string]chan struct{})}
+}
+
+func (m *lockManager) semaphore(key string) chan struct{} {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	sem, ok := m.locks[key]
+	if !ok {
+		sem = make(chan struct{}, 1)
+		m.locks[key] = sem
+	}
+
+	return sem
+}
+
+// lock acquires the semaphore for key, waiting up to timeout before giving up.
+func (m *lockManager) lock(key string, timeout time.Duration) error {
+	select {
+	case m.semaphore(key) <- struct{}{}:
+		return nil
+	case <-time.After(timeout):
+		return fmt.Errorf("timed out after %s waiting to acquire a lock for %q - another operation may still be holding it", timeout, key)
+	}
+}
+
+// unlock releases the semaphore for key. The caller must have previously acquired it via lock.
+func (m *lockManager) unlock(key string) {
+	<-m.semaphore(key)
+}
+
+// armLockManager is the central lock manager used to serialize updates to ARM resources which
+// share a parent (for example a Subnet and the Virtual Network it belongs to), so that concurrent
+// operations against the same parent don't race each other at the API level.
+var armLockManager = newLockManager()
+
 // handle the case of using the same name for different kinds of resources
-func azureStackLockByName(name string, resourceType string) {
+func azureStackLockByName(name string, resourceType string) error {
 	updatedName := resourceType + "." + name
-	armMutexKV.Lock(updatedName)
+	return armLockManager.lock(updatedName, lockTimeout)
 }
 
-func azureStackLockMultipleByName(names *[]string, resourceType string) {
-	for _, name := range *names {
-		azureStackLockByName(name, resourceType)
+// azureStackLockMultipleByName locks names in a deterministic (sorted) order regardless of the
+// order they're passed in, so that two callers locking an overlapping set of the same kind of
+// resource (for example two Network Interfaces which share some but not all of the same Subnets)
+// can never deadlock by acquiring them in opposite order. If a lock can't be acquired within
+// lockTimeout, any locks already acquired by this call are released before returning the error.
+func azureStackLockMultipleByName(names *[]string, resourceType string) error {
+	sorted := append([]string(nil), (*names)...)
+	sort.Strings(sorted)
+
+	for i, name := range sorted {
+		if err := azureStackLockByName(name, resourceType); err != nil {
+			acquired := sorted[:i]
+			azureStackUnlockMultipleByName(&acquired, resourceType)
+			return err
+		}
 	}
+
+	return nil
 }
 
 func azureStackUnlockByName(name string, resourceType string) {
 	updatedName := resourceType + "." + name
-	armMutexKV.Unlock(updatedName)
+	armLockManager.unlock(updatedName)
 }
 
 func azureStackUnlockMultipleByName(names *[]string, resourceType string) {
-	for _, name := range *names {
+	sorted := append([]string(nil), (*names)...)
+	sort.Strings(sorted)
+
+	for _, name := range sorted {
 		azureStackUnlockByName(name, resourceType)
 	}
 }
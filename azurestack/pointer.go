@@ -0,0 +1,39 @@
+package azurestack
+
+// stringValue safely dereferences input, returning "" for a nil pointer - for use in flatten/Read
+// code reading a *string field off a struct whose own presence (but not necessarily every field on
+// it) has already been checked, where dereferencing directly risks a nil pointer panic.
+func stringValue(input *string) string {
+	if input == nil {
+		return ""
+	}
+
+	return *input
+}
+
+// boolValue safely dereferences input, returning false for a nil pointer. See stringValue.
+func boolValue(input *bool) bool {
+	if input == nil {
+		return false
+	}
+
+	return *input
+}
+
+// int32Value safely dereferences input, returning 0 for a nil pointer. See stringValue.
+func int32Value(input *int32) int32 {
+	if input == nil {
+		return 0
+	}
+
+	return *input
+}
+
+// int64Value safely dereferences input, returning 0 for a nil pointer. See stringValue.
+func int64Value(input *int64) int64 {
+	if input == nil {
+		return 0
+	}
+
+	return *input
+}
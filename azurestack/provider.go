@@ -5,12 +5,18 @@ import (
 	"encoding/base64"
 	"encoding/hex"
 	"fmt"
+	"io/ioutil"
 	"strings"
+	"time"
 
+	"github.com/Azure/go-autorest/autorest"
 	"github.com/hashicorp/go-azure-helpers/authentication"
+	"github.com/hashicorp/go-uuid"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/mutexkv"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func Provider() terraform.ResourceProvider {
@@ -52,12 +58,46 @@ func Provider() terraform.ResourceProvider {
 				DefaultFunc: schema.EnvDefaultFunc("ARM_CLIENT_SECRET", ""),
 			},
 
+			"client_secret_file_path": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ARM_CLIENT_SECRET_FILE_PATH", ""),
+			},
+
 			"tenant_id": {
 				Type:        schema.TypeString,
 				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc("ARM_TENANT_ID", ""),
 			},
 
+			"auxiliary_tenant_ids": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 3,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.NoZeroValues,
+				},
+			},
+
+			"use_oidc": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ARM_USE_OIDC", false),
+			},
+
+			"oidc_token": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ARM_OIDC_TOKEN", ""),
+			},
+
+			"oidc_token_file_path": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ARM_OIDC_TOKEN_FILE_PATH", ""),
+			},
+
 			"skip_credentials_validation": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -69,10 +109,130 @@ func Provider() terraform.ResourceProvider {
 				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc("ARM_SKIP_PROVIDER_REGISTRATION", false),
 			},
+
+			"resource_providers_to_register": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.NoZeroValues,
+				},
+			},
+
+			"custom_ca_certificate_path": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ARM_CUSTOM_CA_CERTIFICATE_PATH", ""),
+			},
+
+			"insecure_skip_verify": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ARM_INSECURE_SKIP_VERIFY", false),
+			},
+
+			"proxy_url": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ARM_PROXY_URL", ""),
+			},
+
+			"max_retries": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ARM_MAX_RETRIES", autorest.DefaultRetryAttempts),
+			},
+
+			"retry_wait_min": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ARM_RETRY_WAIT_MIN", int(autorest.DefaultRetryDuration.Seconds())),
+			},
+
+			"retry_wait_max": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ARM_RETRY_WAIT_MAX", 300),
+			},
+
+			"trace_request_logging": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ARM_TRACE_REQUEST_LOGGING", false),
+			},
+
+			"partner_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ARM_PARTNER_ID", ""),
+				ValidateFunc: func(v interface{}, k string) (ws []string, es []error) {
+					if value := v.(string); value != "" {
+						if _, err := uuid.ParseUUID(value); err != nil {
+							es = append(es, fmt.Errorf("%q is not a valid UUID: %+v", k, err))
+						}
+					}
+					return
+				},
+			},
+
+			"disable_correlation_request_id": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ARM_DISABLE_CORRELATION_REQUEST_ID", false),
+			},
+
+			"user_agent_suffix": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ARM_USER_AGENT_SUFFIX", ""),
+			},
+
+			"storage_endpoint_suffix": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ARM_STORAGE_ENDPOINT_SUFFIX", ""),
+			},
+
+			"key_vault_dns_suffix": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ARM_KEY_VAULT_DNS_SUFFIX", ""),
+			},
+
+			"api_profile": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ARM_API_PROFILE", supportedApiProfile),
+				ValidateFunc: func(v interface{}, k string) (ws []string, es []error) {
+					if value := v.(string); value != supportedApiProfile {
+						es = append(es, fmt.Errorf("%q must be %q - this build of the Provider is compiled against a single "+
+							"hybrid API profile's SDK packages and can't be switched to another profile at runtime", k, supportedApiProfile))
+					}
+					return
+				},
+			},
+
+			"features": schemaFeatures(),
+
+			"default_tags": {
+				Type:         schema.TypeMap,
+				Optional:     true,
+				ValidateFunc: validateAzureStackTags,
+			},
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
 			"azurestack_client_config":           dataSourceArmClientConfig(),
+			"azurestack_dns_a_record":            dataSourceArmDnsARecord(),
+			"azurestack_dns_cname_record":        dataSourceArmDnsCNameRecord(),
+			"azurestack_dns_txt_record":          dataSourceArmDnsTxtRecord(),
+			"azurestack_dns_zone":                dataSourceArmDnsZone(),
+			"azurestack_lb":                      dataSourceArmLoadBalancer(),
+			"azurestack_lb_backend_address_pool": dataSourceArmLoadBalancerBackendAddressPool(),
+			"azurestack_lb_nat_pool":             dataSourceArmLoadBalancerNatPool(),
+			"azurestack_lb_probe":                dataSourceArmLoadBalancerProbe(),
+			"azurestack_lb_rule":                 dataSourceArmLoadBalancerRule(),
+			"azurestack_local_network_gateway":   dataSourceArmLocalNetworkGateway(),
 			"azurestack_network_interface":       dataSourceArmNetworkInterface(),
 			"azurestack_network_security_group":  dataSourceArmNetworkSecurityGroup(),
 			"azurestack_platform_image":          dataSourceArmPlatformImage(),
@@ -83,38 +243,56 @@ func Provider() terraform.ResourceProvider {
 			"azurestack_route_table":             dataSourceArmRouteTable(),
 			"azurestack_subnet":                  dataSourceArmSubnet(),
 			"azurestack_virtual_network_gateway": dataSourceArmVirtualNetworkGateway(),
+			"azurestack_virtual_network_peering": dataSourceArmVirtualNetworkPeering(),
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
-			"azurestack_availability_set":                   resourceArmAvailabilitySet(),
-			"azurestack_dns_zone":                           resourceArmDnsZone(),
-			"azurestack_dns_a_record":                       resourceArmDnsARecord(),
-			"azurestack_network_interface":                  resourceArmNetworkInterface(),
-			"azurestack_network_security_group":             resourceArmNetworkSecurityGroup(),
-			"azurestack_network_security_rule":              resourceArmNetworkSecurityRule(),
-			"azurestack_local_network_gateway":              resourceArmLocalNetworkGateway(),
-			"azurestack_lb":                                 resourceArmLoadBalancer(),
-			"azurestack_lb_backend_address_pool":            resourceArmLoadBalancerBackendAddressPool(),
-			"azurestack_lb_nat_rule":                        resourceArmLoadBalancerNatRule(),
-			"azurestack_lb_probe":                           resourceArmLoadBalancerProbe(),
-			"azurestack_lb_nat_pool":                        resourceArmLoadBalancerNatPool(),
-			"azurestack_lb_rule":                            resourceArmLoadBalancerRule(),
-			"azurestack_managed_disk":                       resourceArmManagedDisk(),
-			"azurestack_public_ip":                          resourceArmPublicIp(),
-			"azurestack_resource_group":                     resourceArmResourceGroup(),
-			"azurestack_route":                              resourceArmRoute(),
-			"azurestack_route_table":                        resourceArmRouteTable(),
-			"azurestack_storage_account":                    resourceArmStorageAccount(),
-			"azurestack_storage_blob":                       resourceArmStorageBlob(),
-			"azurestack_storage_container":                  resourceArmStorageContainer(),
-			"azurestack_subnet":                             resourceArmSubnet(),
-			"azurestack_template_deployment":                resourceArmTemplateDeployment(),
-			"azurestack_virtual_network":                    resourceArmVirtualNetwork(),
-			"azurestack_virtual_network_gateway":            resourceArmVirtualNetworkGateway(),
-			"azurestack_virtual_machine":                    resourceArmVirtualMachine(),
-			"azurestack_virtual_machine_extension":          resourceArmVirtualMachineExtensions(),
-			"azurestack_virtual_network_gateway_connection": resourceArmVirtualNetworkGatewayConnection(),
-			"azurestack_virtual_machine_scale_set":          resourceArmVirtualMachineScaleSet(),
+			"azurestack_application_security_group": resourceArmApplicationSecurityGroup(),
+			"azurestack_availability_set":           resourceArmAvailabilitySet(),
+			"azurestack_dns_zone":                   resourceArmDnsZone(),
+			"azurestack_dns_a_record":               resourceArmDnsARecord(),
+			"azurestack_dns_aaaa_record":            resourceArmDnsAAAARecord(),
+			"azurestack_dns_cname_record":           resourceArmDnsCNameRecord(),
+			"azurestack_dns_mx_record":              resourceArmDnsMxRecord(),
+			"azurestack_dns_ns_record":              resourceArmDnsNsRecord(),
+			"azurestack_dns_ptr_record":             resourceArmDnsPtrRecord(),
+			"azurestack_dns_srv_record":             resourceArmDnsSrvRecord(),
+			"azurestack_dns_txt_record":             resourceArmDnsTxtRecord(),
+			"azurestack_network_interface":          resourceArmNetworkInterface(),
+			"azurestack_network_interface_application_security_group_association": resourceArmNetworkInterfaceApplicationSecurityGroupAssociation(),
+			"azurestack_network_interface_backend_address_pool_association":       resourceArmNetworkInterfaceBackendAddressPoolAssociation(),
+			"azurestack_network_interface_nat_rule_association":                   resourceArmNetworkInterfaceNatRuleAssociation(),
+			"azurestack_network_security_group":                                   resourceArmNetworkSecurityGroup(),
+			"azurestack_network_security_rule":                                    resourceArmNetworkSecurityRule(),
+			"azurestack_local_network_gateway":                                    resourceArmLocalNetworkGateway(),
+			"azurestack_lb":                                                       resourceArmLoadBalancer(),
+			"azurestack_lb_backend_address_pool":                                  resourceArmLoadBalancerBackendAddressPool(),
+			"azurestack_lb_frontend_ip_configuration":                             resourceArmLoadBalancerFrontendIpConfiguration(),
+			"azurestack_lb_nat_rule":                                              resourceArmLoadBalancerNatRule(),
+			"azurestack_lb_probe":                                                 resourceArmLoadBalancerProbe(),
+			"azurestack_lb_nat_pool":                                              resourceArmLoadBalancerNatPool(),
+			"azurestack_lb_rule":                                                  resourceArmLoadBalancerRule(),
+			"azurestack_managed_disk":                                             resourceArmManagedDisk(),
+			"azurestack_public_ip":                                                resourceArmPublicIp(),
+			"azurestack_resource_group":                                           resourceArmResourceGroup(),
+			"azurestack_route":                                                    resourceArmRoute(),
+			"azurestack_route_table":                                              resourceArmRouteTable(),
+			"azurestack_storage_account":                                          resourceArmStorageAccount(),
+			"azurestack_storage_blob":                                             resourceArmStorageBlob(),
+			"azurestack_storage_container":                                        resourceArmStorageContainer(),
+			"azurestack_subnet":                                                   resourceArmSubnet(),
+			"azurestack_subnet_network_security_group_association":                resourceArmSubnetNetworkSecurityGroupAssociation(),
+			"azurestack_subnet_route_table_association":                           resourceArmSubnetRouteTableAssociation(),
+			"azurestack_template_deployment":                                      resourceArmTemplateDeployment(),
+			"azurestack_virtual_network":                                          resourceArmVirtualNetwork(),
+			"azurestack_virtual_network_peering":                                  resourceArmVirtualNetworkPeering(),
+			"azurestack_virtual_network_gateway":                                  resourceArmVirtualNetworkGateway(),
+			"azurestack_virtual_machine":                                          resourceArmVirtualMachine(),
+			"azurestack_virtual_machine_extension":                                resourceArmVirtualMachineExtensions(),
+			"azurestack_virtual_network_gateway_connection":                       resourceArmVirtualNetworkGatewayConnection(),
+			"azurestack_virtual_network_dns_servers":                              resourceArmVirtualNetworkDnsServers(),
+			"azurestack_virtual_machine_scale_set":                                resourceArmVirtualMachineScaleSet(),
+			"azurestack_virtual_machine_scale_set_lb_association":                 resourceArmVirtualMachineScaleSetLoadBalancerAssociation(),
 		},
 	}
 
@@ -125,31 +303,79 @@ func Provider() terraform.ResourceProvider {
 
 func providerConfigure(p *schema.Provider) schema.ConfigureFunc {
 	return func(d *schema.ResourceData) (interface{}, error) {
-		builder := authentication.Builder{
-			SubscriptionID:                d.Get("subscription_id").(string),
-			ClientID:                      d.Get("client_id").(string),
-			ClientSecret:                  d.Get("client_secret").(string),
-			TenantID:                      d.Get("tenant_id").(string),
-			ClientCertPath:                d.Get("client_certificate_path").(string),
-			ClientCertPassword:            d.Get("client_certificate_password").(string),
-			CustomResourceManagerEndpoint: d.Get("arm_endpoint").(string),
-			Environment:                   "AZURESTACKCLOUD",
-
-			// Feature Toggles
-			SupportsAzureCliToken:    true,
-			SupportsClientSecretAuth: true,
-			SupportsClientCertAuth:   true,
-		}
-		config, err := builder.Build()
-		if err != nil {
-			return nil, fmt.Errorf("Error building ARM Client: %+v", err)
+		armEndpoint := d.Get("arm_endpoint").(string)
+		if armEndpoint == "" {
+			return nil, fmt.Errorf("`arm_endpoint` must be configured - this is required to determine the Azure Stack " +
+				"environment being targeted, regardless of whether you're authenticating via the Azure CLI or a Service Principal")
 		}
 
 		skipCredentialsValidation := d.Get("skip_credentials_validation").(bool)
 		skipProviderRegistration := d.Get("skip_provider_registration").(bool)
-		client, err := getArmClient(config, p.TerraformVersion, skipProviderRegistration)
-		if err != nil {
-			return nil, err
+		sender := senderSettings{
+			CustomCACertPath:    d.Get("custom_ca_certificate_path").(string),
+			InsecureSkipVerify:  d.Get("insecure_skip_verify").(bool),
+			ProxyURL:            d.Get("proxy_url").(string),
+			MaxRetries:          d.Get("max_retries").(int),
+			RetryWaitMin:        time.Duration(d.Get("retry_wait_min").(int)) * time.Second,
+			RetryWaitMax:        time.Duration(d.Get("retry_wait_max").(int)) * time.Second,
+			TraceRequestLogging: d.Get("trace_request_logging").(bool),
+		}
+		partnerID := d.Get("partner_id").(string)
+		disableCorrelationRequestID := d.Get("disable_correlation_request_id").(bool)
+		userAgentSuffix := d.Get("user_agent_suffix").(string)
+		storageEndpointSuffix := d.Get("storage_endpoint_suffix").(string)
+		keyVaultDNSSuffix := d.Get("key_vault_dns_suffix").(string)
+
+		var client *ArmClient
+		if d.Get("use_oidc").(bool) {
+			oidcClient, err := providerConfigureOidc(d, armEndpoint, p.TerraformVersion, skipProviderRegistration, sender, partnerID, disableCorrelationRequestID, userAgentSuffix, storageEndpointSuffix, keyVaultDNSSuffix)
+			if err != nil {
+				return nil, err
+			}
+			client = oidcClient
+		} else {
+			clientSecret, err := loadClientSecret(d.Get("client_secret").(string), d.Get("client_secret_file_path").(string))
+			if err != nil {
+				return nil, err
+			}
+
+			builder := authentication.Builder{
+				SubscriptionID:                d.Get("subscription_id").(string),
+				ClientID:                      d.Get("client_id").(string),
+				ClientSecret:                  clientSecret,
+				TenantID:                      d.Get("tenant_id").(string),
+				ClientCertPath:                d.Get("client_certificate_path").(string),
+				ClientCertPassword:            d.Get("client_certificate_password").(string),
+				CustomResourceManagerEndpoint: armEndpoint,
+				Environment:                   "AZURESTACKCLOUD",
+				AuxiliaryTenantIDs:            *utils.ExpandStringSlice(d.Get("auxiliary_tenant_ids").([]interface{})),
+
+				// Feature Toggles
+				SupportsAzureCliToken:    true,
+				SupportsClientSecretAuth: true,
+				SupportsClientCertAuth:   true,
+				SupportsAuxiliaryTenants: len(d.Get("auxiliary_tenant_ids").([]interface{})) > 0,
+			}
+			config, err := builder.Build()
+			if err != nil {
+				return nil, fmt.Errorf("Error building ARM Client: %+v", err)
+			}
+
+			builtClient, err := getArmClient(config, p.TerraformVersion, skipProviderRegistration, sender, partnerID, disableCorrelationRequestID, userAgentSuffix, storageEndpointSuffix, keyVaultDNSSuffix)
+			if err != nil {
+				return nil, err
+			}
+			client = builtClient
+		}
+
+		client.features = expandFeatures(d.Get("features").([]interface{}))
+
+		defaultTags := d.Get("default_tags").(map[string]interface{})
+		client.defaultTags = make(map[string]string, len(defaultTags))
+		for k, v := range defaultTags {
+			// Validate should have ignored this error already
+			value, _ := tagValueToString(v)
+			client.defaultTags[k] = value
 		}
 
 		client.StopContext = p.StopContext()
@@ -167,14 +393,56 @@ func providerConfigure(p *schema.Provider) schema.ConfigureFunc {
 			providerList, err := client.providersClient.List(ctx, nil, "")
 			if err != nil {
 				return nil, fmt.Errorf("Unable to list provider registration status, it is possible that this is due to invalid "+
-					"credentials or the service principal does not have permission to use the Resource Manager API, Azure "+
-					"error: %s", err)
+					"credentials, an incorrect `tenant_id`, or the service principal not having permission to use the Resource "+
+					"Manager API against the environment below - Azure error: %s\n\n"+
+					"Detected Environment:\n"+
+					"  Resource Manager Endpoint: %s\n"+
+					"  Active Directory Endpoint: %s\n"+
+					"  Token Audience:            %s\n"+
+					"  Graph Endpoint:             %s",
+					err, client.environment.ResourceManagerEndpoint, client.environment.ActiveDirectoryEndpoint,
+					client.environment.TokenAudience, client.environment.GraphEndpoint)
+			}
+
+			client.validLocations = make(map[string]struct{})
+			for _, provider := range providerList.Values() {
+				if provider.ResourceTypes == nil {
+					continue
+				}
+				for _, resourceType := range *provider.ResourceTypes {
+					if resourceType.Locations == nil {
+						continue
+					}
+					for _, location := range *resourceType.Locations {
+						if location != "" {
+							client.validLocations[azureStackNormalizeLocation(location)] = struct{}{}
+						}
+					}
+				}
 			}
 
+			// every generated client's *Sender already wraps requests with
+			// azure.DoRetryWithRegistration, which registers a Resource Provider on demand the
+			// first time a request against it comes back with `MissingSubscriptionRegistration` -
+			// so nothing needs to be eagerly registered here for the common case. The exception is
+			// `resource_providers_to_register`: an operator who explicitly lists Resource Providers
+			// is asserting those namespaces exist on this stamp and wants them registered up front,
+			// so honor that opt-in rather than guessing at a fixed namespace list that may not exist
+			// on every stamp (Azure Stack Hub deployments vary in which Resource Providers are
+			// installed).
 			if !skipProviderRegistration {
-				err = ensureResourceProvidersAreRegistered(ctx, client.providersClient, providerList.Values(), requiredResourceProviders())
-				if err != nil {
-					return nil, err
+				if custom := d.Get("resource_providers_to_register").(*schema.Set); custom.Len() > 0 {
+					requiredRPs := make(map[string]struct{}, custom.Len())
+					for _, rp := range custom.List() {
+						requiredRPs[rp.(string)] = struct{}{}
+					}
+
+					err = ensureResourceProvidersAreRegistered(ctx, client.providersClient, providerList.Values(), requiredRPs)
+					if err != nil {
+						return nil, fmt.Errorf("%+v. If the Service Principal being used lacks `*/register/action` "+
+							"permissions to register Resource Providers then `skip_provider_registration` should be set to "+
+							"`true` and the Resource Providers registered ahead of time by an account with sufficient permissions", err)
+					}
 				}
 			}
 		}
@@ -183,6 +451,22 @@ func providerConfigure(p *schema.Provider) schema.ConfigureFunc {
 	}
 }
 
+// loadClientSecret returns the Client Secret which should be used to authenticate, either taken
+// directly from `client_secret` or read from the file at `client_secret_file_path` - so that a
+// secret rotated on disk by an external process (such as a Vault agent) is picked up without
+// needing to re-render the Provider configuration.
+func loadClientSecret(secret, secretFilePath string) (string, error) {
+	if secretFilePath != "" {
+		contents, err := ioutil.ReadFile(secretFilePath)
+		if err != nil {
+			return "", fmt.Errorf("Error reading `client_secret_file_path` %q: %+v", secretFilePath, err)
+		}
+		return strings.TrimSpace(string(contents)), nil
+	}
+
+	return secret, nil
+}
+
 // armMutexKV is the instance of MutexKV for ARM resources
 var armMutexKV = mutexkv.NewMutexKV()
 
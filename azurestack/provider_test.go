@@ -83,3 +83,28 @@ func testGetAzureConfig(t *testing.T) *authentication.Config {
 
 	return config
 }
+
+// buildSweeperClient returns an *ArmClient built directly from the ARM_* Environment Variables,
+// for use by TestSweepers registered with resource.AddTestSweepers - which run outside of a
+// *testing.T (and so can't call testGetAzureConfig or skip via t.Skip) ahead of `go test`
+// executing the acceptance tests themselves.
+func buildSweeperClient() (*ArmClient, error) {
+	builder := authentication.Builder{
+		SubscriptionID:                os.Getenv("ARM_SUBSCRIPTION_ID"),
+		ClientID:                      os.Getenv("ARM_CLIENT_ID"),
+		TenantID:                      os.Getenv("ARM_TENANT_ID"),
+		ClientSecret:                  os.Getenv("ARM_CLIENT_SECRET"),
+		CustomResourceManagerEndpoint: os.Getenv("ARM_ENDPOINT"),
+		Environment:                   "AZURESTACKCLOUD",
+
+		// Feature Toggles
+		SupportsClientSecretAuth: true,
+	}
+
+	config, err := builder.Build()
+	if err != nil {
+		return nil, fmt.Errorf("Error building ARM Client: %+v", err)
+	}
+
+	return getArmClient(config, "sweeper", false, senderSettings{}, "", false, "", "", "")
+}
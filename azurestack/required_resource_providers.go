@@ -8,11 +8,13 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourceproviders"
 )
 
-// requiredResourceProviders returns all of the Resource Providers used by the AzureStack Provider
-// whilst all may not be used by every user - the intention is that we determine which should be
-// registered such that we can avoid obscure errors where Resource Providers aren't registered.
-// new Resource Providers should be added to this list as they're used in the Provider
-// (this is the approach used by Microsoft in their tooling)
+// requiredResourceProviders returns the full set of Resource Providers used by the AzureStack
+// Provider. It isn't registered automatically during Configure - not every stamp has every one of
+// these namespaces installed, and eagerly registering all of them regardless of what a
+// configuration actually uses produced noisy failures on stamps missing one. Each generated ARM
+// client already registers the Resource Provider it needs on demand (see
+// azure.DoRetryWithRegistration, wired into every client's Sender), so this list is only used by
+// acceptance tests wanting every Resource Provider registered ahead of a full test run.
 func requiredResourceProviders() map[string]struct{} {
 	// NOTE: Resource Providers in this list are case sensitive
 	return map[string]struct{}{
@@ -14,7 +14,7 @@ func TestAccAzureStackEnsureRequiredResourceProvidersAreRegistered(t *testing.T)
 	}
 
 	// this test intentionally checks all the RP's are registered - so this is intentional
-	armClient, err := getArmClient(config, "0.0.0", true)
+	armClient, err := getArmClient(config, "0.0.0", true, senderSettings{}, "", false, "", "", "")
 	if err != nil {
 		t.Fatalf("Error building ARM Client: %+v", err)
 	}
@@ -0,0 +1,132 @@
+package azurestack
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/2019-03-01/network/mgmt/network"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func resourceArmApplicationSecurityGroup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmApplicationSecurityGroupCreateUpdate,
+		Read:   resourceArmApplicationSecurityGroupRead,
+		Update: resourceArmApplicationSecurityGroupCreateUpdate,
+		Delete: resourceArmApplicationSecurityGroupDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: customizeDiffTagsWithDefaultTags,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"location": locationSchema(),
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmApplicationSecurityGroupCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).applicationSecurityGroupsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	log.Printf("[INFO] preparing arguments for AzureStack Application Security Group creation.")
+
+	name := d.Get("name").(string)
+	location := azureStackNormalizeLocation(d.Get("location").(string))
+	resGroup := d.Get("resource_group_name").(string)
+	tags := d.Get("tags").(map[string]interface{})
+
+	group := network.ApplicationSecurityGroup{
+		Name:     &name,
+		Location: &location,
+		Tags:     *expandTags(meta, tags),
+	}
+
+	future, err := client.CreateOrUpdate(ctx, resGroup, name, group)
+	if err != nil {
+		return fmt.Errorf("Error Creating/Updating Application Security Group %q (Resource Group %q): %+v", name, resGroup, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for completion of Application Security Group %q (Resource Group %q): %+v", name, resGroup, err)
+	}
+
+	read, err := client.Get(ctx, resGroup, name)
+	if err != nil {
+		return err
+	}
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read Application Security Group %q (Resource Group %q) ID", name, resGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmApplicationSecurityGroupRead(d, meta)
+}
+
+func resourceArmApplicationSecurityGroupRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).applicationSecurityGroupsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resGroup := id.ResourceGroup
+	name := id.Path["applicationSecurityGroups"]
+
+	resp, err := client.Get(ctx, resGroup, name)
+	if err != nil {
+		if wasNotFound(resp.Response) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error making Read request on Application Security Group %q: %+v", name, err)
+	}
+
+	d.Set("name", name)
+	d.Set("resource_group_name", resGroup)
+	if location := resp.Location; location != nil {
+		d.Set("location", azureStackNormalizeLocation(*location))
+	}
+
+	flattenAndSetTags(d, &resp.Tags)
+
+	return nil
+}
+
+func resourceArmApplicationSecurityGroupDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).applicationSecurityGroupsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resGroup := id.ResourceGroup
+	name := id.Path["applicationSecurityGroups"]
+
+	future, err := client.Delete(ctx, resGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error deleting Application Security Group %q (Resource Group %q): %+v", name, resGroup, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for deletion of Application Security Group %q (Resource Group %q): %+v", name, resGroup, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,174 @@
+package azurestack
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/azure-sdk-for-go/services/dns/mgmt/2016-04-01/dns"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func resourceArmDnsAAAARecord() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmDnsAAAARecordCreateOrUpdate,
+		Read:   resourceArmDnsAAAARecordRead,
+		Update: resourceArmDnsAAAARecordCreateOrUpdate,
+		Delete: resourceArmDnsAAAARecordDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: customizeDiffTagsWithDefaultTags,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"zone_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"records": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+
+			"ttl": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmDnsAAAARecordCreateOrUpdate(d *schema.ResourceData, meta interface{}) error {
+	dnsClient := meta.(*ArmClient).dnsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	name := d.Get("name").(string)
+	resGroup := d.Get("resource_group_name").(string)
+	zoneName := d.Get("zone_name").(string)
+	ttl := int64(d.Get("ttl").(int))
+	tags := d.Get("tags").(map[string]interface{})
+
+	records, err := expandAzureStackDnsAAAARecords(d)
+	if err != nil {
+		return err
+	}
+
+	parameters := dns.RecordSet{
+		Name: &name,
+		RecordSetProperties: &dns.RecordSetProperties{
+			Metadata:    *expandTags(meta, tags),
+			TTL:         &ttl,
+			AaaaRecords: &records,
+		},
+	}
+
+	eTag := ""
+	ifNoneMatch := "" // set to empty to allow updates to records after creation
+	resp, err := dnsClient.CreateOrUpdate(ctx, resGroup, zoneName, name, "AAAA", parameters, eTag, ifNoneMatch)
+	if err != nil {
+		return err
+	}
+
+	if resp.ID == nil {
+		return fmt.Errorf("Cannot read DNS AAAA Record %s (resource group %s) ID", name, resGroup)
+	}
+
+	d.SetId(*resp.ID)
+
+	return resourceArmDnsAAAARecordRead(d, meta)
+}
+
+func resourceArmDnsAAAARecordRead(d *schema.ResourceData, meta interface{}) error {
+	dnsClient := meta.(*ArmClient).dnsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resGroup := id.ResourceGroup
+	name := id.Path["AAAA"]
+	zoneName := id.Path["dnszones"]
+
+	resp, err := dnsClient.Get(ctx, resGroup, zoneName, name, dns.AAAA)
+	if err != nil {
+		if wasNotFound(resp.Response) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading DNS AAAA record %s: %+v", name, err)
+	}
+
+	d.Set("name", name)
+	d.Set("resource_group_name", resGroup)
+	d.Set("zone_name", zoneName)
+	d.Set("ttl", resp.TTL)
+
+	if err := d.Set("records", flattenAzureStackDnsAAAARecords(resp.AaaaRecords)); err != nil {
+		return err
+	}
+	flattenAndSetTags(d, &resp.Metadata)
+
+	return nil
+}
+
+func resourceArmDnsAAAARecordDelete(d *schema.ResourceData, meta interface{}) error {
+	dnsClient := meta.(*ArmClient).dnsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resGroup := id.ResourceGroup
+	name := id.Path["AAAA"]
+	zoneName := id.Path["dnszones"]
+
+	resp, error := dnsClient.Delete(ctx, resGroup, zoneName, name, dns.AAAA, "")
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("Error deleting DNS AAAA Record %s: %+v", name, error)
+	}
+
+	return nil
+}
+
+func flattenAzureStackDnsAAAARecords(records *[]dns.AaaaRecord) []string {
+	results := make([]string, 0, len(*records))
+
+	if records != nil {
+		for _, record := range *records {
+			results = append(results, *record.Ipv6Address)
+		}
+	}
+
+	return results
+}
+
+func expandAzureStackDnsAAAARecords(d *schema.ResourceData) ([]dns.AaaaRecord, error) {
+	recordStrings := d.Get("records").(*schema.Set).List()
+	records := make([]dns.AaaaRecord, len(recordStrings))
+
+	for i, v := range recordStrings {
+		ipv6 := v.(string)
+		records[i] = dns.AaaaRecord{
+			Ipv6Address: &ipv6,
+		}
+	}
+
+	return records, nil
+}
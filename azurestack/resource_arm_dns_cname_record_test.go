@@ -0,0 +1,165 @@
+package azurestack
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/dns/mgmt/2016-04-01/dns"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func TestAccAzureStackDnsCNameRecord_basic(t *testing.T) {
+	resourceName := "azurestack_dns_cname_record.test"
+	ri := acctest.RandInt()
+	config := testAccAzureStackDnsCNameRecord_basic(ri, testLocation())
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureStackDnsCNameRecordDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureStackDnsCNameRecordExists(resourceName),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureStackDnsCNameRecord_updateRecord(t *testing.T) {
+	resourceName := "azurestack_dns_cname_record.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+	preConfig := testAccAzureStackDnsCNameRecord_basic(ri, location)
+	postConfig := testAccAzureStackDnsCNameRecord_updateRecord(ri, location)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureStackDnsCNameRecordDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: preConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureStackDnsCNameRecordExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "record", "contoso.com"),
+				),
+			},
+			{
+				Config: postConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureStackDnsCNameRecordExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "record", "contoso2.com"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureStackDnsCNameRecordExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		cName := rs.Primary.Attributes["name"]
+		zoneName := rs.Primary.Attributes["zone_name"]
+		resourceGroup, hasResourceGroup := rs.Primary.Attributes["resource_group_name"]
+		if !hasResourceGroup {
+			return fmt.Errorf("Bad: no resource group found in state for DNS CNAME record: %s", cName)
+		}
+
+		conn := testAccProvider.Meta().(*ArmClient).dnsClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+		resp, err := conn.Get(ctx, resourceGroup, zoneName, cName, dns.CNAME)
+		if err != nil {
+			return fmt.Errorf("Bad: Get CNAME RecordSet: %+v", err)
+		}
+
+		if resp.StatusCode == http.StatusNotFound {
+			return fmt.Errorf("Bad: DNS CNAME record %s (resource group: %s) does not exist", cName, resourceGroup)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureStackDnsCNameRecordDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*ArmClient).dnsClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurestack_dns_cname_record" {
+			continue
+		}
+
+		cName := rs.Primary.Attributes["name"]
+		zoneName := rs.Primary.Attributes["zone_name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		resp, err := conn.Get(ctx, resourceGroup, zoneName, cName, dns.CNAME)
+
+		if err != nil {
+			if resp.StatusCode == http.StatusNotFound {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("DNS CNAME record still exists:\n%#v", resp.RecordSetProperties)
+	}
+
+	return nil
+}
+
+func testAccAzureStackDnsCNameRecord_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurestack_resource_group" "test" {
+  name     = "acctestRG_%d"
+  location = "%s"
+}
+
+resource "azurestack_dns_zone" "test" {
+  name                = "acctestzone%d.com"
+  resource_group_name = "${azurestack_resource_group.test.name}"
+}
+
+resource "azurestack_dns_cname_record" "test" {
+  name                = "mycnamerecord%d"
+  resource_group_name = "${azurestack_resource_group.test.name}"
+  zone_name           = "${azurestack_dns_zone.test.name}"
+  ttl                 = 300
+  record              = "contoso.com"
+}
+`, rInt, location, rInt, rInt)
+}
+
+func testAccAzureStackDnsCNameRecord_updateRecord(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurestack_resource_group" "test" {
+  name     = "acctestRG_%d"
+  location = "%s"
+}
+
+resource "azurestack_dns_zone" "test" {
+  name                = "acctestzone%d.com"
+  resource_group_name = "${azurestack_resource_group.test.name}"
+}
+
+resource "azurestack_dns_cname_record" "test" {
+  name                = "mycnamerecord%d"
+  resource_group_name = "${azurestack_resource_group.test.name}"
+  zone_name           = "${azurestack_dns_zone.test.name}"
+  ttl                 = 300
+  record              = "contoso2.com"
+}
+`, rInt, location, rInt, rInt)
+}
@@ -0,0 +1,201 @@
+package azurestack
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/azure-sdk-for-go/services/dns/mgmt/2016-04-01/dns"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/hashcode"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func resourceArmDnsMxRecord() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmDnsMxRecordCreateOrUpdate,
+		Read:   resourceArmDnsMxRecordRead,
+		Update: resourceArmDnsMxRecordCreateOrUpdate,
+		Delete: resourceArmDnsMxRecordDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: customizeDiffTagsWithDefaultTags,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"zone_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"record": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"preference": {
+							Type:     schema.TypeInt,
+							Required: true,
+						},
+
+						"exchange": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+				Set: resourceArmDnsMxRecordHash,
+			},
+
+			"ttl": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmDnsMxRecordHash(v interface{}) int {
+	var buf bytes.Buffer
+	m := v.(map[string]interface{})
+	buf.WriteString(fmt.Sprintf("%d-", m["preference"].(int)))
+	buf.WriteString(fmt.Sprintf("%s-", m["exchange"].(string)))
+
+	return hashcode.String(buf.String())
+}
+
+func resourceArmDnsMxRecordCreateOrUpdate(d *schema.ResourceData, meta interface{}) error {
+	dnsClient := meta.(*ArmClient).dnsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	name := d.Get("name").(string)
+	resGroup := d.Get("resource_group_name").(string)
+	zoneName := d.Get("zone_name").(string)
+	ttl := int64(d.Get("ttl").(int))
+	tags := d.Get("tags").(map[string]interface{})
+
+	records := expandAzureStackDnsMxRecords(d)
+
+	parameters := dns.RecordSet{
+		Name: &name,
+		RecordSetProperties: &dns.RecordSetProperties{
+			Metadata:  *expandTags(meta, tags),
+			TTL:       &ttl,
+			MxRecords: &records,
+		},
+	}
+
+	eTag := ""
+	ifNoneMatch := "" // set to empty to allow updates to records after creation
+	resp, err := dnsClient.CreateOrUpdate(ctx, resGroup, zoneName, name, "MX", parameters, eTag, ifNoneMatch)
+	if err != nil {
+		return err
+	}
+
+	if resp.ID == nil {
+		return fmt.Errorf("Cannot read DNS MX Record %s (resource group %s) ID", name, resGroup)
+	}
+
+	d.SetId(*resp.ID)
+
+	return resourceArmDnsMxRecordRead(d, meta)
+}
+
+func resourceArmDnsMxRecordRead(d *schema.ResourceData, meta interface{}) error {
+	dnsClient := meta.(*ArmClient).dnsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resGroup := id.ResourceGroup
+	name := id.Path["MX"]
+	zoneName := id.Path["dnszones"]
+
+	resp, err := dnsClient.Get(ctx, resGroup, zoneName, name, dns.MX)
+	if err != nil {
+		if wasNotFound(resp.Response) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading DNS MX record %s: %+v", name, err)
+	}
+
+	d.Set("name", name)
+	d.Set("resource_group_name", resGroup)
+	d.Set("zone_name", zoneName)
+	d.Set("ttl", resp.TTL)
+
+	if err := d.Set("record", flattenAzureStackDnsMxRecords(resp.MxRecords)); err != nil {
+		return err
+	}
+	flattenAndSetTags(d, &resp.Metadata)
+
+	return nil
+}
+
+func resourceArmDnsMxRecordDelete(d *schema.ResourceData, meta interface{}) error {
+	dnsClient := meta.(*ArmClient).dnsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resGroup := id.ResourceGroup
+	name := id.Path["MX"]
+	zoneName := id.Path["dnszones"]
+
+	resp, error := dnsClient.Delete(ctx, resGroup, zoneName, name, dns.MX, "")
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("Error deleting DNS MX Record %s: %+v", name, error)
+	}
+
+	return nil
+}
+
+func flattenAzureStackDnsMxRecords(records *[]dns.MxRecord) []map[string]interface{} {
+	results := make([]map[string]interface{}, 0)
+
+	if records != nil {
+		for _, record := range *records {
+			results = append(results, map[string]interface{}{
+				"preference": int(*record.Preference),
+				"exchange":   *record.Exchange,
+			})
+		}
+	}
+
+	return results
+}
+
+func expandAzureStackDnsMxRecords(d *schema.ResourceData) []dns.MxRecord {
+	recordStrings := d.Get("record").(*schema.Set).List()
+	records := make([]dns.MxRecord, len(recordStrings))
+
+	for i, v := range recordStrings {
+		mxrecord := v.(map[string]interface{})
+		preference := int32(mxrecord["preference"].(int))
+		exchange := mxrecord["exchange"].(string)
+
+		records[i] = dns.MxRecord{
+			Preference: &preference,
+			Exchange:   &exchange,
+		}
+	}
+
+	return records
+}
@@ -0,0 +1,219 @@
+package azurestack
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/azure-sdk-for-go/services/dns/mgmt/2016-04-01/dns"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/hashcode"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func resourceArmDnsSrvRecord() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmDnsSrvRecordCreateOrUpdate,
+		Read:   resourceArmDnsSrvRecordRead,
+		Update: resourceArmDnsSrvRecordCreateOrUpdate,
+		Delete: resourceArmDnsSrvRecordDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: customizeDiffTagsWithDefaultTags,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"zone_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"record": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"priority": {
+							Type:     schema.TypeInt,
+							Required: true,
+						},
+
+						"weight": {
+							Type:     schema.TypeInt,
+							Required: true,
+						},
+
+						"port": {
+							Type:     schema.TypeInt,
+							Required: true,
+						},
+
+						"target": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+				Set: resourceArmDnsSrvRecordHash,
+			},
+
+			"ttl": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmDnsSrvRecordHash(v interface{}) int {
+	var buf bytes.Buffer
+	m := v.(map[string]interface{})
+	buf.WriteString(fmt.Sprintf("%d-", m["priority"].(int)))
+	buf.WriteString(fmt.Sprintf("%d-", m["weight"].(int)))
+	buf.WriteString(fmt.Sprintf("%d-", m["port"].(int)))
+	buf.WriteString(fmt.Sprintf("%s-", m["target"].(string)))
+
+	return hashcode.String(buf.String())
+}
+
+func resourceArmDnsSrvRecordCreateOrUpdate(d *schema.ResourceData, meta interface{}) error {
+	dnsClient := meta.(*ArmClient).dnsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	name := d.Get("name").(string)
+	resGroup := d.Get("resource_group_name").(string)
+	zoneName := d.Get("zone_name").(string)
+	ttl := int64(d.Get("ttl").(int))
+	tags := d.Get("tags").(map[string]interface{})
+
+	records := expandAzureStackDnsSrvRecords(d)
+
+	parameters := dns.RecordSet{
+		Name: &name,
+		RecordSetProperties: &dns.RecordSetProperties{
+			Metadata:   *expandTags(meta, tags),
+			TTL:        &ttl,
+			SrvRecords: &records,
+		},
+	}
+
+	eTag := ""
+	ifNoneMatch := "" // set to empty to allow updates to records after creation
+	resp, err := dnsClient.CreateOrUpdate(ctx, resGroup, zoneName, name, "SRV", parameters, eTag, ifNoneMatch)
+	if err != nil {
+		return err
+	}
+
+	if resp.ID == nil {
+		return fmt.Errorf("Cannot read DNS SRV Record %s (resource group %s) ID", name, resGroup)
+	}
+
+	d.SetId(*resp.ID)
+
+	return resourceArmDnsSrvRecordRead(d, meta)
+}
+
+func resourceArmDnsSrvRecordRead(d *schema.ResourceData, meta interface{}) error {
+	dnsClient := meta.(*ArmClient).dnsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resGroup := id.ResourceGroup
+	name := id.Path["SRV"]
+	zoneName := id.Path["dnszones"]
+
+	resp, err := dnsClient.Get(ctx, resGroup, zoneName, name, dns.SRV)
+	if err != nil {
+		if wasNotFound(resp.Response) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading DNS SRV record %s: %+v", name, err)
+	}
+
+	d.Set("name", name)
+	d.Set("resource_group_name", resGroup)
+	d.Set("zone_name", zoneName)
+	d.Set("ttl", resp.TTL)
+
+	if err := d.Set("record", flattenAzureStackDnsSrvRecords(resp.SrvRecords)); err != nil {
+		return err
+	}
+	flattenAndSetTags(d, &resp.Metadata)
+
+	return nil
+}
+
+func resourceArmDnsSrvRecordDelete(d *schema.ResourceData, meta interface{}) error {
+	dnsClient := meta.(*ArmClient).dnsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resGroup := id.ResourceGroup
+	name := id.Path["SRV"]
+	zoneName := id.Path["dnszones"]
+
+	resp, error := dnsClient.Delete(ctx, resGroup, zoneName, name, dns.SRV, "")
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("Error deleting DNS SRV Record %s: %+v", name, error)
+	}
+
+	return nil
+}
+
+func flattenAzureStackDnsSrvRecords(records *[]dns.SrvRecord) []map[string]interface{} {
+	results := make([]map[string]interface{}, 0)
+
+	if records != nil {
+		for _, record := range *records {
+			results = append(results, map[string]interface{}{
+				"priority": int(*record.Priority),
+				"weight":   int(*record.Weight),
+				"port":     int(*record.Port),
+				"target":   *record.Target,
+			})
+		}
+	}
+
+	return results
+}
+
+func expandAzureStackDnsSrvRecords(d *schema.ResourceData) []dns.SrvRecord {
+	recordStrings := d.Get("record").(*schema.Set).List()
+	records := make([]dns.SrvRecord, len(recordStrings))
+
+	for i, v := range recordStrings {
+		srvrecord := v.(map[string]interface{})
+		priority := int32(srvrecord["priority"].(int))
+		weight := int32(srvrecord["weight"].(int))
+		port := int32(srvrecord["port"].(int))
+		target := srvrecord["target"].(string)
+
+		records[i] = dns.SrvRecord{
+			Priority: &priority,
+			Weight:   &weight,
+			Port:     &port,
+			Target:   &target,
+		}
+	}
+
+	return records
+}
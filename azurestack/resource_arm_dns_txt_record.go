@@ -0,0 +1,215 @@
+package azurestack
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/azure-sdk-for-go/services/dns/mgmt/2016-04-01/dns"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/hashcode"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// dnsTxtRecordMaxChunkLength is the maximum length of a single character-string
+// within a TXT record, per RFC 1035. Longer values are split into multiple
+// character-strings and re-joined on read.
+const dnsTxtRecordMaxChunkLength = 255
+
+func resourceArmDnsTxtRecord() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmDnsTxtRecordCreateOrUpdate,
+		Read:   resourceArmDnsTxtRecordRead,
+		Update: resourceArmDnsTxtRecordCreateOrUpdate,
+		Delete: resourceArmDnsTxtRecordDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: customizeDiffTagsWithDefaultTags,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"zone_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"record": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"value": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+				Set: resourceArmDnsTxtRecordHash,
+			},
+
+			"ttl": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmDnsTxtRecordHash(v interface{}) int {
+	var buf bytes.Buffer
+	m := v.(map[string]interface{})
+	buf.WriteString(fmt.Sprintf("%s-", m["value"].(string)))
+
+	return hashcode.String(buf.String())
+}
+
+func resourceArmDnsTxtRecordCreateOrUpdate(d *schema.ResourceData, meta interface{}) error {
+	dnsClient := meta.(*ArmClient).dnsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	name := d.Get("name").(string)
+	resGroup := d.Get("resource_group_name").(string)
+	zoneName := d.Get("zone_name").(string)
+	ttl := int64(d.Get("ttl").(int))
+	tags := d.Get("tags").(map[string]interface{})
+
+	records := expandAzureStackDnsTxtRecords(d)
+
+	parameters := dns.RecordSet{
+		Name: &name,
+		RecordSetProperties: &dns.RecordSetProperties{
+			Metadata:   *expandTags(meta, tags),
+			TTL:        &ttl,
+			TxtRecords: &records,
+		},
+	}
+
+	eTag := ""
+	ifNoneMatch := "" // set to empty to allow updates to records after creation
+	resp, err := dnsClient.CreateOrUpdate(ctx, resGroup, zoneName, name, "TXT", parameters, eTag, ifNoneMatch)
+	if err != nil {
+		return err
+	}
+
+	if resp.ID == nil {
+		return fmt.Errorf("Cannot read DNS TXT Record %s (resource group %s) ID", name, resGroup)
+	}
+
+	d.SetId(*resp.ID)
+
+	return resourceArmDnsTxtRecordRead(d, meta)
+}
+
+func resourceArmDnsTxtRecordRead(d *schema.ResourceData, meta interface{}) error {
+	dnsClient := meta.(*ArmClient).dnsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resGroup := id.ResourceGroup
+	name := id.Path["TXT"]
+	zoneName := id.Path["dnszones"]
+
+	resp, err := dnsClient.Get(ctx, resGroup, zoneName, name, dns.TXT)
+	if err != nil {
+		if wasNotFound(resp.Response) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading DNS TXT record %s: %+v", name, err)
+	}
+
+	d.Set("name", name)
+	d.Set("resource_group_name", resGroup)
+	d.Set("zone_name", zoneName)
+	d.Set("ttl", resp.TTL)
+
+	if err := d.Set("record", flattenAzureStackDnsTxtRecords(resp.TxtRecords)); err != nil {
+		return err
+	}
+	flattenAndSetTags(d, &resp.Metadata)
+
+	return nil
+}
+
+func resourceArmDnsTxtRecordDelete(d *schema.ResourceData, meta interface{}) error {
+	dnsClient := meta.(*ArmClient).dnsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resGroup := id.ResourceGroup
+	name := id.Path["TXT"]
+	zoneName := id.Path["dnszones"]
+
+	resp, error := dnsClient.Delete(ctx, resGroup, zoneName, name, dns.TXT, "")
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("Error deleting DNS TXT Record %s: %+v", name, error)
+	}
+
+	return nil
+}
+
+func flattenAzureStackDnsTxtRecords(records *[]dns.TxtRecord) []map[string]interface{} {
+	results := make([]map[string]interface{}, 0)
+
+	if records != nil {
+		for _, record := range *records {
+			if record.Value == nil {
+				continue
+			}
+
+			var value bytes.Buffer
+			for _, chunk := range *record.Value {
+				value.WriteString(chunk)
+			}
+
+			results = append(results, map[string]interface{}{
+				"value": value.String(),
+			})
+		}
+	}
+
+	return results
+}
+
+func expandAzureStackDnsTxtRecords(d *schema.ResourceData) []dns.TxtRecord {
+	recordStrings := d.Get("record").(*schema.Set).List()
+	records := make([]dns.TxtRecord, len(recordStrings))
+
+	for i, v := range recordStrings {
+		txtrecord := v.(map[string]interface{})
+		value := txtrecord["value"].(string)
+
+		records[i] = dns.TxtRecord{
+			Value: &[]string{value},
+		}
+		if len(value) > dnsTxtRecordMaxChunkLength {
+			var chunks []string
+			for len(value) > dnsTxtRecordMaxChunkLength {
+				chunks = append(chunks, value[:dnsTxtRecordMaxChunkLength])
+				value = value[dnsTxtRecordMaxChunkLength:]
+			}
+			chunks = append(chunks, value)
+			records[i].Value = &chunks
+		}
+	}
+
+	return records
+}
@@ -0,0 +1,170 @@
+package azurestack
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/dns/mgmt/2016-04-01/dns"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func TestAccAzureStackDnsTxtRecord_basic(t *testing.T) {
+	resourceName := "azurestack_dns_txt_record.test"
+	ri := acctest.RandInt()
+	config := testAccAzureStackDnsTxtRecord_basic(ri, testLocation())
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureStackDnsTxtRecordDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureStackDnsTxtRecordExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "record.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureStackDnsTxtRecord_longValue(t *testing.T) {
+	resourceName := "azurestack_dns_txt_record.test"
+	ri := acctest.RandInt()
+	config := testAccAzureStackDnsTxtRecord_longValue(ri, testLocation())
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureStackDnsTxtRecordDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureStackDnsTxtRecordExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "record.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureStackDnsTxtRecordExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		txtName := rs.Primary.Attributes["name"]
+		zoneName := rs.Primary.Attributes["zone_name"]
+		resourceGroup, hasResourceGroup := rs.Primary.Attributes["resource_group_name"]
+		if !hasResourceGroup {
+			return fmt.Errorf("Bad: no resource group found in state for DNS TXT record: %s", txtName)
+		}
+
+		conn := testAccProvider.Meta().(*ArmClient).dnsClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+		resp, err := conn.Get(ctx, resourceGroup, zoneName, txtName, dns.TXT)
+		if err != nil {
+			return fmt.Errorf("Bad: Get TXT RecordSet: %+v", err)
+		}
+
+		if resp.StatusCode == http.StatusNotFound {
+			return fmt.Errorf("Bad: DNS TXT record %s (resource group: %s) does not exist", txtName, resourceGroup)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureStackDnsTxtRecordDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*ArmClient).dnsClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurestack_dns_txt_record" {
+			continue
+		}
+
+		txtName := rs.Primary.Attributes["name"]
+		zoneName := rs.Primary.Attributes["zone_name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		resp, err := conn.Get(ctx, resourceGroup, zoneName, txtName, dns.TXT)
+
+		if err != nil {
+			if resp.StatusCode == http.StatusNotFound {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("DNS TXT record still exists:\n%#v", resp.RecordSetProperties)
+	}
+
+	return nil
+}
+
+func testAccAzureStackDnsTxtRecord_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurestack_resource_group" "test" {
+  name     = "acctestRG_%d"
+  location = "%s"
+}
+
+resource "azurestack_dns_zone" "test" {
+  name                = "acctestzone%d.com"
+  resource_group_name = "${azurestack_resource_group.test.name}"
+}
+
+resource "azurestack_dns_txt_record" "test" {
+  name                = "mytxtrecord%d"
+  resource_group_name = "${azurestack_resource_group.test.name}"
+  zone_name           = "${azurestack_dns_zone.test.name}"
+  ttl                 = 300
+
+  record {
+    value = "v=spf1 include:contoso.com ~all"
+  }
+
+  record {
+    value = "google-site-verification=abcdefg"
+  }
+}
+`, rInt, location, rInt, rInt)
+}
+
+func testAccAzureStackDnsTxtRecord_longValue(rInt int, location string) string {
+	value := strings.Repeat("a", 300)
+
+	return fmt.Sprintf(`
+resource "azurestack_resource_group" "test" {
+  name     = "acctestRG_%d"
+  location = "%s"
+}
+
+resource "azurestack_dns_zone" "test" {
+  name                = "acctestzone%d.com"
+  resource_group_name = "${azurestack_resource_group.test.name}"
+}
+
+resource "azurestack_dns_txt_record" "test" {
+  name                = "mytxtrecord%d"
+  resource_group_name = "${azurestack_resource_group.test.name}"
+  zone_name           = "${azurestack_dns_zone.test.name}"
+  ttl                 = 300
+
+  record {
+    value = "%s"
+  }
+}
+`, rInt, location, rInt, rInt, value)
+}
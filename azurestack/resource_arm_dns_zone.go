@@ -5,8 +5,6 @@ import (
 
 	"github.com/Azure/azure-sdk-for-go/services/dns/mgmt/2016-04-01/dns"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmDnsZone() *schema.Resource {
@@ -19,6 +17,8 @@ func resourceArmDnsZone() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: customizeDiffTagsWithDefaultTags,
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -62,7 +62,7 @@ func resourceArmDnsZoneCreate(d *schema.ResourceData, meta interface{}) error {
 
 	parameters := dns.Zone{
 		Location: &location,
-		Tags:     *expandTags(tags),
+		Tags:     *expandTags(meta, tags),
 	}
 
 	etag := ""
@@ -95,7 +95,7 @@ func resourceArmDnsZoneRead(d *schema.ResourceData, meta interface{}) error {
 
 	resp, err := zonesClient.Get(ctx, resGroup, name)
 	if err != nil {
-		if utils.ResponseWasNotFound(resp.Response) {
+		if wasNotFound(resp.Response) {
 			d.SetId("")
 			return nil
 		}
@@ -133,7 +133,7 @@ func resourceArmDnsZoneDelete(d *schema.ResourceData, meta interface{}) error {
 	etag := ""
 	future, err := client.Delete(ctx, resGroup, name, etag)
 	if err != nil {
-		if response.WasNotFound(future.Response()) {
+		if wasNotFoundRaw(future.Response()) {
 			return nil
 		}
 		return fmt.Errorf("Error deleting DNS zone %s (resource group %s): %+v", name, resGroup, err)
@@ -141,7 +141,7 @@ func resourceArmDnsZoneDelete(d *schema.ResourceData, meta interface{}) error {
 
 	err = future.WaitForCompletionRef(ctx, client.Client)
 	if err != nil {
-		if response.WasNotFound(future.Response()) {
+		if wasNotFoundRaw(future.Response()) {
 			return nil
 		}
 		return fmt.Errorf("Error deleting DNS zone %s (resource group %s): %+v", name, resGroup, err)
@@ -27,6 +27,14 @@ func resourceArmLoadBalancer() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		CustomizeDiff: customizeDiffTagsWithDefaultTags,
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -118,6 +126,197 @@ func resourceArmLoadBalancer() *schema.Resource {
 				},
 			},
 
+			// `probe`, `rule` and `nat_pool` are an alternative, inline style of managing these
+			// sub-resources for users with large LoadBalancers who want to avoid a PUT per
+			// sub-resource - as opposed to the standalone azurestack_lb_probe/_rule/_nat_pool
+			// resources, which are the more common style and remain fully supported alongside these
+			"probe": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+
+						"protocol": {
+							Type:             schema.TypeString,
+							Computed:         true,
+							Optional:         true,
+							StateFunc:        ignoreCaseStateFunc,
+							DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(network.ProbeProtocolHTTP),
+								string(network.ProbeProtocolTCP),
+							}, true),
+						},
+
+						"port": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(0, 65535),
+						},
+
+						"request_path": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+
+						"interval_in_seconds": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      15,
+							ValidateFunc: validation.IntBetween(5, 3600),
+						},
+
+						"number_of_probes": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      2,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+					},
+				},
+			},
+
+			"rule": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateArmLoadBalancerRuleName,
+						},
+
+						"frontend_ip_configuration_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+
+						"backend_address_pool_id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+
+						"protocol": {
+							Type:             schema.TypeString,
+							Required:         true,
+							StateFunc:        ignoreCaseStateFunc,
+							DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(network.TransportProtocolUDP),
+								string(network.TransportProtocolTCP),
+							}, true),
+						},
+
+						"frontend_port": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validate.PortNumber,
+						},
+
+						"backend_port": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validate.PortNumber,
+						},
+
+						"probe_id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: azure.ValidateResourceID,
+						},
+
+						"enable_floating_ip": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+
+						"idle_timeout_in_minutes": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntBetween(4, 30),
+						},
+
+						"load_distribution": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Computed:         true,
+							StateFunc:        ignoreCaseStateFunc,
+							DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(network.Default),
+								string(network.SourceIP),
+								string(network.SourceIPProtocol),
+							}, true),
+						},
+
+						"disable_outbound_snat": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+
+			"nat_pool": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+
+						"frontend_ip_configuration_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+
+						"protocol": {
+							Type:             schema.TypeString,
+							Required:         true,
+							StateFunc:        ignoreCaseStateFunc,
+							DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(network.TransportProtocolTCP),
+								string(network.TransportProtocolUDP),
+							}, true),
+						},
+
+						"frontend_port_start": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validate.PortNumber,
+						},
+
+						"frontend_port_end": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validate.PortNumber,
+						},
+
+						"backend_port": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validate.PortNumber,
+						},
+					},
+				},
+			},
+
 			"tags": tagsSchema(),
 		},
 	}
@@ -134,7 +333,7 @@ func resourceArmLoadBalancerCreate(d *schema.ResourceData, meta interface{}) err
 	resGroup := d.Get("resource_group_name").(string)
 
 	tags := d.Get("tags").(map[string]interface{})
-	expandedTags := expandTags(tags)
+	expandedTags := expandTags(meta, tags)
 
 	properties := network.LoadBalancerPropertiesFormat{}
 
@@ -142,6 +341,48 @@ func resourceArmLoadBalancerCreate(d *schema.ResourceData, meta interface{}) err
 		properties.FrontendIPConfigurations = expandAzureRmLoadBalancerFrontendIpConfigurations(d)
 	}
 
+	if !d.IsNewResource() {
+		existing, exists, err := retrieveLoadBalancerById(d.Id(), meta)
+		if err != nil {
+			return fmt.Errorf("Error retrieving LoadBalancer %q (Resource Group %q): %+v", name, resGroup, err)
+		}
+
+		// CreateOrUpdate is a full replace of the LoadBalancer, so any sub-resource not set below -
+		// whether managed by a standalone azurestack_lb_probe/_rule/_nat_pool resource, or by this
+		// resource's own inline blocks on a previous apply - needs to be carried forward here or it
+		// would otherwise be silently deleted by this Update
+		if exists && existing.LoadBalancerPropertiesFormat != nil {
+			properties.Probes = existing.LoadBalancerPropertiesFormat.Probes
+			properties.LoadBalancingRules = existing.LoadBalancerPropertiesFormat.LoadBalancingRules
+			properties.InboundNatPools = existing.LoadBalancerPropertiesFormat.InboundNatPools
+			properties.InboundNatRules = existing.LoadBalancerPropertiesFormat.InboundNatRules
+		}
+	}
+
+	if v, ok := d.GetOk("probe"); ok {
+		if err := detectLoadBalancerInlineConflict(d, "probe", loadBalancerProbeNames(properties.Probes)); err != nil {
+			return err
+		}
+
+		properties.Probes = expandAzureRmLoadBalancerInlineProbes(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("nat_pool"); ok {
+		if err := detectLoadBalancerInlineConflict(d, "nat_pool", loadBalancerNatPoolNames(properties.InboundNatPools)); err != nil {
+			return err
+		}
+
+		properties.InboundNatPools = expandAzureRmLoadBalancerInlineNatPools(v.([]interface{}), meta, resGroup, name)
+	}
+
+	if v, ok := d.GetOk("rule"); ok {
+		if err := detectLoadBalancerInlineConflict(d, "rule", loadBalancerRuleNames(properties.LoadBalancingRules)); err != nil {
+			return err
+		}
+
+		properties.LoadBalancingRules = expandAzureRmLoadBalancerInlineRules(v.([]interface{}), meta, resGroup, name)
+	}
+
 	loadBalancer := network.LoadBalancer{
 		Name:                         utils.String(name),
 		Location:                     utils.String(location),
@@ -175,9 +416,9 @@ func resourceArmLoadBalancerCreate(d *schema.ResourceData, meta interface{}) err
 		Pending: []string{"Accepted", "Updating"},
 		Target:  []string{"Succeeded"},
 		Refresh: loadbalancerStateRefreshFunc(ctx, client, resGroup, name),
-		Timeout: 10 * time.Minute,
+		Timeout: d.Timeout(schema.TimeoutCreate),
 	}
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := waitForStateContext(ctx, stateConf); err != nil {
 		return fmt.Errorf("Error waiting for LoadBalancer (%q - Resource Group %q) to become available: %s", name, resGroup, err)
 	}
 
@@ -227,6 +468,13 @@ func resourceArmLoadBalancerRead(d *schema.ResourceData, meta interface{}) error
 			d.Set("private_ip_address", privateIpAddress)
 			d.Set("private_ip_addresses", privateIpAddresses)
 		}
+
+		// only the sub-resources still named in this resource's own `probe`/`rule`/`nat_pool` blocks
+		// are flattened back - anything else on the LoadBalancer belongs to a standalone
+		// azurestack_lb_probe/_rule/_nat_pool resource and is left for that resource's own Read
+		d.Set("probe", flattenLoadBalancerInlineProbes(props.Probes, d))
+		d.Set("nat_pool", flattenLoadBalancerInlineNatPools(props.InboundNatPools, d))
+		d.Set("rule", flattenLoadBalancerInlineRules(props.LoadBalancingRules, d))
 	}
 
 	flattenAndSetTags(d, &loadBalancer.Tags)
@@ -343,3 +591,315 @@ func flattenLoadBalancerFrontendIpConfiguration(ipConfigs *[]network.FrontendIPC
 	}
 	return result
 }
+
+// loadBalancerSubResourceID builds the ARM resource ID a sub-resource of a LoadBalancer will have
+// once created, without needing it to exist yet - unlike the standalone azurestack_lb_* resources,
+// which resolve a sibling's ID with a GET against the already-applied LoadBalancer, an inline block
+// may reference a frontend IP configuration being created in the very same apply. Azure resource IDs
+// for these are deterministic paths, so the ID can be constructed directly instead.
+func loadBalancerSubResourceID(meta interface{}, resourceGroup, loadBalancerName, kind, name string) string {
+	subscriptionID := meta.(*ArmClient).subscriptionId
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/loadBalancers/%s/%s/%s",
+		subscriptionID, resourceGroup, loadBalancerName, kind, name)
+}
+
+// detectLoadBalancerInlineConflict returns an error if a name being applied via one of this
+// resource's inline blocks (blockKey) collides with a sub-resource of that type which this resource
+// didn't itself put there on a previous apply - almost always a standalone azurestack_lb_* resource
+// managing a sub-resource of the same name, which would otherwise flap between the two on every
+// apply of either resource.
+func detectLoadBalancerInlineConflict(d *schema.ResourceData, blockKey string, existingNames map[string]bool) error {
+	old, new := d.GetChange(blockKey)
+	oldNames := loadBalancerInlineBlockNames(old)
+
+	for name := range loadBalancerInlineBlockNames(new) {
+		if existingNames[name] && !oldNames[name] {
+			return fmt.Errorf("a %q sub-resource named %q already exists on this LoadBalancer and isn't managed by this resource's `%s` block - remove the standalone resource managing it (or rename one of them) before adding it here", blockKey, name, blockKey)
+		}
+	}
+
+	return nil
+}
+
+func loadBalancerInlineBlockNames(v interface{}) map[string]bool {
+	names := make(map[string]bool)
+	for _, raw := range v.([]interface{}) {
+		if item, ok := raw.(map[string]interface{}); ok {
+			if name, ok := item["name"].(string); ok && name != "" {
+				names[name] = true
+			}
+		}
+	}
+	return names
+}
+
+func loadBalancerProbeNames(probes *[]network.Probe) map[string]bool {
+	names := make(map[string]bool)
+	if probes == nil {
+		return names
+	}
+	for _, probe := range *probes {
+		if probe.Name != nil {
+			names[*probe.Name] = true
+		}
+	}
+	return names
+}
+
+func loadBalancerNatPoolNames(pools *[]network.InboundNatPool) map[string]bool {
+	names := make(map[string]bool)
+	if pools == nil {
+		return names
+	}
+	for _, pool := range *pools {
+		if pool.Name != nil {
+			names[*pool.Name] = true
+		}
+	}
+	return names
+}
+
+func loadBalancerRuleNames(rules *[]network.LoadBalancingRule) map[string]bool {
+	names := make(map[string]bool)
+	if rules == nil {
+		return names
+	}
+	for _, rule := range *rules {
+		if rule.Name != nil {
+			names[*rule.Name] = true
+		}
+	}
+	return names
+}
+
+func expandAzureRmLoadBalancerInlineProbes(input []interface{}) *[]network.Probe {
+	probes := make([]network.Probe, 0, len(input))
+
+	for _, raw := range input {
+		data := raw.(map[string]interface{})
+
+		properties := network.ProbePropertiesFormat{
+			NumberOfProbes:    utils.Int32(int32(data["number_of_probes"].(int))),
+			IntervalInSeconds: utils.Int32(int32(data["interval_in_seconds"].(int))),
+			Port:              utils.Int32(int32(data["port"].(int))),
+		}
+
+		if v := data["protocol"].(string); v != "" {
+			properties.Protocol = network.ProbeProtocol(v)
+		}
+
+		if v := data["request_path"].(string); v != "" {
+			properties.RequestPath = utils.String(v)
+		}
+
+		probes = append(probes, network.Probe{
+			Name:                  utils.String(data["name"].(string)),
+			ProbePropertiesFormat: &properties,
+		})
+	}
+
+	return &probes
+}
+
+func expandAzureRmLoadBalancerInlineNatPools(input []interface{}, meta interface{}, resourceGroup, loadBalancerName string) *[]network.InboundNatPool {
+	pools := make([]network.InboundNatPool, 0, len(input))
+
+	for _, raw := range input {
+		data := raw.(map[string]interface{})
+
+		frontendConfigName := data["frontend_ip_configuration_name"].(string)
+		properties := network.InboundNatPoolPropertiesFormat{
+			Protocol:               network.TransportProtocol(data["protocol"].(string)),
+			FrontendPortRangeStart: utils.Int32(int32(data["frontend_port_start"].(int))),
+			FrontendPortRangeEnd:   utils.Int32(int32(data["frontend_port_end"].(int))),
+			BackendPort:            utils.Int32(int32(data["backend_port"].(int))),
+			FrontendIPConfiguration: &network.SubResource{
+				ID: utils.String(loadBalancerSubResourceID(meta, resourceGroup, loadBalancerName, "frontendIPConfigurations", frontendConfigName)),
+			},
+		}
+
+		pools = append(pools, network.InboundNatPool{
+			Name:                           utils.String(data["name"].(string)),
+			InboundNatPoolPropertiesFormat: &properties,
+		})
+	}
+
+	return &pools
+}
+
+func expandAzureRmLoadBalancerInlineRules(input []interface{}, meta interface{}, resourceGroup, loadBalancerName string) *[]network.LoadBalancingRule {
+	rules := make([]network.LoadBalancingRule, 0, len(input))
+
+	for _, raw := range input {
+		data := raw.(map[string]interface{})
+
+		frontendConfigName := data["frontend_ip_configuration_name"].(string)
+		properties := network.LoadBalancingRulePropertiesFormat{
+			Protocol:             network.TransportProtocol(data["protocol"].(string)),
+			FrontendPort:         utils.Int32(int32(data["frontend_port"].(int))),
+			BackendPort:          utils.Int32(int32(data["backend_port"].(int))),
+			EnableFloatingIP:     utils.Bool(data["enable_floating_ip"].(bool)),
+			DisableOutboundSnat:  utils.Bool(data["disable_outbound_snat"].(bool)),
+			IdleTimeoutInMinutes: utils.Int32(int32(data["idle_timeout_in_minutes"].(int))),
+			FrontendIPConfiguration: &network.SubResource{
+				ID: utils.String(loadBalancerSubResourceID(meta, resourceGroup, loadBalancerName, "frontendIPConfigurations", frontendConfigName)),
+			},
+		}
+
+		if v := data["backend_address_pool_id"].(string); v != "" {
+			properties.BackendAddressPool = &network.SubResource{ID: utils.String(v)}
+		}
+
+		if v := data["probe_id"].(string); v != "" {
+			properties.Probe = &network.SubResource{ID: utils.String(v)}
+		}
+
+		if v := data["load_distribution"].(string); v != "" {
+			properties.LoadDistribution = network.LoadDistribution(v)
+		}
+
+		rules = append(rules, network.LoadBalancingRule{
+			Name:                              utils.String(data["name"].(string)),
+			LoadBalancingRulePropertiesFormat: &properties,
+		})
+	}
+
+	return &rules
+}
+
+func flattenLoadBalancerInlineProbes(probes *[]network.Probe, d *schema.ResourceData) []interface{} {
+	names := loadBalancerInlineBlockNames(d.Get("probe"))
+	result := make([]interface{}, 0)
+	if probes == nil {
+		return result
+	}
+
+	for _, probe := range *probes {
+		if probe.Name == nil || !names[*probe.Name] {
+			continue
+		}
+
+		item := map[string]interface{}{
+			"name": *probe.Name,
+		}
+
+		if props := probe.ProbePropertiesFormat; props != nil {
+			item["protocol"] = string(props.Protocol)
+			item["request_path"] = ""
+			if props.Port != nil {
+				item["port"] = int(*props.Port)
+			}
+			if props.RequestPath != nil {
+				item["request_path"] = *props.RequestPath
+			}
+			if props.IntervalInSeconds != nil {
+				item["interval_in_seconds"] = int(*props.IntervalInSeconds)
+			}
+			if props.NumberOfProbes != nil {
+				item["number_of_probes"] = int(*props.NumberOfProbes)
+			}
+		}
+
+		result = append(result, item)
+	}
+
+	return result
+}
+
+func flattenLoadBalancerInlineNatPools(pools *[]network.InboundNatPool, d *schema.ResourceData) []interface{} {
+	names := loadBalancerInlineBlockNames(d.Get("nat_pool"))
+	result := make([]interface{}, 0)
+	if pools == nil {
+		return result
+	}
+
+	for _, pool := range *pools {
+		if pool.Name == nil || !names[*pool.Name] {
+			continue
+		}
+
+		item := map[string]interface{}{
+			"name": *pool.Name,
+		}
+
+		if props := pool.InboundNatPoolPropertiesFormat; props != nil {
+			item["protocol"] = string(props.Protocol)
+			if props.FrontendPortRangeStart != nil {
+				item["frontend_port_start"] = int(*props.FrontendPortRangeStart)
+			}
+			if props.FrontendPortRangeEnd != nil {
+				item["frontend_port_end"] = int(*props.FrontendPortRangeEnd)
+			}
+			if props.BackendPort != nil {
+				item["backend_port"] = int(*props.BackendPort)
+			}
+			if fip := props.FrontendIPConfiguration; fip != nil && fip.ID != nil {
+				if fipID, err := parseAzureResourceID(*fip.ID); err == nil {
+					item["frontend_ip_configuration_name"] = fipID.Path["frontendIPConfigurations"]
+				}
+			}
+		}
+
+		result = append(result, item)
+	}
+
+	return result
+}
+
+func flattenLoadBalancerInlineRules(rules *[]network.LoadBalancingRule, d *schema.ResourceData) []interface{} {
+	names := loadBalancerInlineBlockNames(d.Get("rule"))
+	result := make([]interface{}, 0)
+	if rules == nil {
+		return result
+	}
+
+	for _, rule := range *rules {
+		if rule.Name == nil || !names[*rule.Name] {
+			continue
+		}
+
+		item := map[string]interface{}{
+			"name":                    *rule.Name,
+			"backend_address_pool_id": "",
+			"probe_id":                "",
+			"load_distribution":       "",
+		}
+
+		if props := rule.LoadBalancingRulePropertiesFormat; props != nil {
+			item["protocol"] = string(props.Protocol)
+			if props.FrontendPort != nil {
+				item["frontend_port"] = int(*props.FrontendPort)
+			}
+			if props.BackendPort != nil {
+				item["backend_port"] = int(*props.BackendPort)
+			}
+			if props.EnableFloatingIP != nil {
+				item["enable_floating_ip"] = *props.EnableFloatingIP
+			}
+			if props.DisableOutboundSnat != nil {
+				item["disable_outbound_snat"] = *props.DisableOutboundSnat
+			}
+			if props.IdleTimeoutInMinutes != nil {
+				item["idle_timeout_in_minutes"] = int(*props.IdleTimeoutInMinutes)
+			}
+			if props.LoadDistribution != "" {
+				item["load_distribution"] = string(props.LoadDistribution)
+			}
+			if props.BackendAddressPool != nil && props.BackendAddressPool.ID != nil {
+				item["backend_address_pool_id"] = *props.BackendAddressPool.ID
+			}
+			if props.Probe != nil && props.Probe.ID != nil {
+				item["probe_id"] = *props.Probe.ID
+			}
+			if fip := props.FrontendIPConfiguration; fip != nil && fip.ID != nil {
+				if fipID, err := parseAzureResourceID(*fip.ID); err == nil {
+					item["frontend_ip_configuration_name"] = fipID.Path["frontendIPConfigurations"]
+				}
+			}
+		}
+
+		result = append(result, item)
+	}
+
+	return result
+}
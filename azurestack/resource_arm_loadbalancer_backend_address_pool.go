@@ -6,7 +6,6 @@ import (
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2017-10-01/network"
-	"github.com/hashicorp/errwrap"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
@@ -58,50 +57,38 @@ func resourceArmLoadBalancerBackendAddressPoolCreate(d *schema.ResourceData, met
 	ctx := meta.(*ArmClient).StopContext
 
 	loadBalancerID := d.Get("loadbalancer_id").(string)
-	armMutexKV.Lock(loadBalancerID)
-	defer armMutexKV.Unlock(loadBalancerID)
-
-	loadBalancer, exists, err := retrieveLoadBalancerById(loadBalancerID, meta)
-	if err != nil {
-		return errwrap.Wrapf("Error Getting LoadBalancer By ID {{err}}", err)
-	}
-	if !exists {
-		d.SetId("")
-		log.Printf("[INFO] LoadBalancer %q not found. Removing from state", d.Get("name").(string))
-		return nil
-	}
-
-	backendAddressPools := append(*loadBalancer.LoadBalancerPropertiesFormat.BackendAddressPools, expandAzureRmLoadBalancerBackendAddressPools(d))
-	existingPool, existingPoolIndex, exists := findLoadBalancerBackEndAddressPoolByName(loadBalancer, d.Get("name").(string))
-	if exists {
-		if d.Get("name").(string) == *existingPool.Name {
-			// this pool is being updated/reapplied remove old copy from the slice
-			backendAddressPools = append(backendAddressPools[:existingPoolIndex], backendAddressPools[existingPoolIndex+1:]...)
+	name := d.Get("name").(string)
+
+	// queued rather than applied directly - see applyLoadBalancerWrite - so that this Backend
+	// Address Pool's write is coalesced with any other azurestack_lb_* sub-resource writes against
+	// the same LoadBalancer happening concurrently, into a single CreateOrUpdate of the parent
+	// LoadBalancer
+	read, err := applyLoadBalancerWrite(ctx, meta, loadBalancerID, func(loadBalancer *network.LoadBalancer) error {
+		backendAddressPools := append(*loadBalancer.LoadBalancerPropertiesFormat.BackendAddressPools, expandAzureRmLoadBalancerBackendAddressPools(d))
+		existingPool, existingPoolIndex, exists := findLoadBalancerBackEndAddressPoolByName(loadBalancer, name)
+		if exists {
+			if name == *existingPool.Name {
+				// this pool is being updated/reapplied remove old copy from the slice
+				backendAddressPools = append(backendAddressPools[:existingPoolIndex], backendAddressPools[existingPoolIndex+1:]...)
+			}
 		}
-	}
-
-	loadBalancer.LoadBalancerPropertiesFormat.BackendAddressPools = &backendAddressPools
-	resGroup, loadBalancerName, err := resourceGroupAndLBNameFromId(d.Get("loadbalancer_id").(string))
-	if err != nil {
-		return fmt.Errorf("Error parsing LoadBalancer Name and Group: %+v", err)
-	}
 
-	future, err := client.CreateOrUpdate(ctx, resGroup, loadBalancerName, *loadBalancer)
+		loadBalancer.LoadBalancerPropertiesFormat.BackendAddressPools = &backendAddressPools
+		return nil
+	})
 	if err != nil {
-		return fmt.Errorf("Error Creating/Updating LoadBalancer %q (Resource Group %q): %+v", loadBalancerName, resGroup, err)
-	}
+		if _, exists, existsErr := retrieveLoadBalancerById(loadBalancerID, meta); existsErr == nil && !exists {
+			d.SetId("")
+			log.Printf("[INFO] LoadBalancer %q not found. Removing from state", name)
+			return nil
+		}
 
-	err = future.WaitForCompletionRef(ctx, client.Client)
-	if err != nil {
-		return fmt.Errorf("Error Creating/Updating LoadBalancer %q (Resource Group %q): %+v", loadBalancerName, resGroup, err)
+		return fmt.Errorf("Error Creating/Updating LoadBalancer: %+v", err)
 	}
 
-	read, err := client.Get(ctx, resGroup, loadBalancerName, "")
+	resGroup, loadBalancerName, err := resourceGroupAndLBNameFromId(loadBalancerID)
 	if err != nil {
-		return fmt.Errorf("Error retrieving Load Balancer %q (Resource Group %q): %+v", loadBalancerName, resGroup, err)
-	}
-	if read.ID == nil {
-		return fmt.Errorf("Cannot read LoadBalancer %q (Resource Group %q) ID", loadBalancerName, resGroup)
+		return fmt.Errorf("Error parsing LoadBalancer Name and Group: %+v", err)
 	}
 
 	if read.LoadBalancerPropertiesFormat == nil {
@@ -109,8 +96,8 @@ func resourceArmLoadBalancerBackendAddressPoolCreate(d *schema.ResourceData, met
 	}
 
 	var poolId string
-	for _, BackendAddressPool := range *(*read.LoadBalancerPropertiesFormat).BackendAddressPools {
-		if *BackendAddressPool.Name == d.Get("name").(string) {
+	for _, BackendAddressPool := range *read.LoadBalancerPropertiesFormat.BackendAddressPools {
+		if *BackendAddressPool.Name == name {
 			poolId = *BackendAddressPool.ID
 		}
 	}
@@ -129,7 +116,7 @@ func resourceArmLoadBalancerBackendAddressPoolCreate(d *schema.ResourceData, met
 		Refresh: loadbalancerStateRefreshFunc(ctx, client, resGroup, loadBalancerName),
 		Timeout: 10 * time.Minute,
 	}
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := waitForStateContext(ctx, stateConf); err != nil {
 		return fmt.Errorf("Error waiting for LoadBalancer (%q Resource Group %q) to become available: %+v", loadBalancerName, resGroup, err)
 	}
 
@@ -137,27 +124,27 @@ func resourceArmLoadBalancerBackendAddressPoolCreate(d *schema.ResourceData, met
 }
 
 func resourceArmLoadBalancerBackendAddressPoolRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).loadBalancerBackendPoolClient
+	ctx := meta.(*ArmClient).StopContext
+
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
 		return err
 	}
 	name := id.Path["backendAddressPools"]
+	loadBalancerName := id.Path["loadBalancers"]
 
-	loadBalancer, exists, err := retrieveLoadBalancerById(d.Get("loadbalancer_id").(string), meta)
+	// only a targeted GET against this Backend Address Pool - not the entire parent LoadBalancer,
+	// which would mean a full LoadBalancer retrieval for every pool on it during a Refresh
+	config, err := client.Get(ctx, id.ResourceGroup, loadBalancerName, name)
 	if err != nil {
-		return fmt.Errorf("Error retrieving Load Balancer by ID: %+v", err)
-	}
-	if !exists {
-		d.SetId("")
-		log.Printf("[INFO] LoadBalancer %q not found. Removing from state", name)
-		return nil
-	}
+		if wasNotFound(config.Response) {
+			log.Printf("[INFO] LoadBalancer Backend Address Pool %q not found. Removing from state", name)
+			d.SetId("")
+			return nil
+		}
 
-	config, _, exists := findLoadBalancerBackEndAddressPoolByName(loadBalancer, name)
-	if !exists {
-		d.SetId("")
-		log.Printf("[INFO] LoadBalancer Backend Address Pool %q not found. Removing from state", name)
-		return nil
+		return fmt.Errorf("Error retrieving LoadBalancer Backend Address Pool %q (LoadBalancer %q): %+v", name, loadBalancerName, err)
 	}
 
 	d.Set("name", config.Name)
@@ -187,54 +174,31 @@ func resourceArmLoadBalancerBackendAddressPoolRead(d *schema.ResourceData, meta
 }
 
 func resourceArmLoadBalancerBackendAddressPoolDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).loadBalancerClient
 	ctx := meta.(*ArmClient).StopContext
 
 	loadBalancerID := d.Get("loadbalancer_id").(string)
-	armMutexKV.Lock(loadBalancerID)
-	defer armMutexKV.Unlock(loadBalancerID)
+	name := d.Get("name").(string)
 
-	loadBalancer, exists, err := retrieveLoadBalancerById(loadBalancerID, meta)
-	if err != nil {
-		return fmt.Errorf("Error retrieving Load Balancer by ID: %+v", err)
-	}
-	if !exists {
-		d.SetId("")
-		return nil
-	}
+	_, err := applyLoadBalancerWrite(ctx, meta, loadBalancerID, func(loadBalancer *network.LoadBalancer) error {
+		_, index, exists := findLoadBalancerBackEndAddressPoolByName(loadBalancer, name)
+		if !exists {
+			return nil
+		}
 
-	_, index, exists := findLoadBalancerBackEndAddressPoolByName(loadBalancer, d.Get("name").(string))
-	if !exists {
+		oldBackEndPools := *loadBalancer.LoadBalancerPropertiesFormat.BackendAddressPools
+		newBackEndPools := append(oldBackEndPools[:index], oldBackEndPools[index+1:]...)
+		loadBalancer.LoadBalancerPropertiesFormat.BackendAddressPools = &newBackEndPools
 		return nil
-	}
-
-	oldBackEndPools := *loadBalancer.LoadBalancerPropertiesFormat.BackendAddressPools
-	newBackEndPools := append(oldBackEndPools[:index], oldBackEndPools[index+1:]...)
-	loadBalancer.LoadBalancerPropertiesFormat.BackendAddressPools = &newBackEndPools
-
-	resGroup, loadBalancerName, err := resourceGroupAndLBNameFromId(d.Get("loadbalancer_id").(string))
+	})
 	if err != nil {
-		return errwrap.Wrapf("Error Getting LoadBalancer Name and Group: {{err}}", err)
-	}
+		if _, exists, existsErr := retrieveLoadBalancerById(loadBalancerID, meta); existsErr == nil && !exists {
+			d.SetId("")
+			return nil
+		}
 
-	future, err := client.CreateOrUpdate(ctx, resGroup, loadBalancerName, *loadBalancer)
-	if err != nil {
 		return fmt.Errorf("Error Creating/Updating LoadBalancer: %+v", err)
 	}
 
-	err = future.WaitForCompletionRef(ctx, client.Client)
-	if err != nil {
-		return fmt.Errorf("Error waiting for the completion for the LoadBalancer: %+v", err)
-	}
-
-	read, err := client.Get(ctx, resGroup, loadBalancerName, "")
-	if err != nil {
-		return fmt.Errorf("Error retrieving the LoadBalancer %q (Resource Group %q): %+v", loadBalancerName, resGroup, err)
-	}
-	if read.ID == nil {
-		return fmt.Errorf("Cannot read LoadBalancer %q (resource group %q) ID", loadBalancerName, resGroup)
-	}
-
 	return nil
 }
 
@@ -0,0 +1,283 @@
+package azurestack
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2017-10-01/network"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+	"github.com/terraform-providers/terraform-provider-azurestack/azurestack/helpers/azure"
+)
+
+func resourceArmLoadBalancerFrontendIpConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmLoadBalancerFrontendIpConfigurationCreateUpdate,
+		Read:   resourceArmLoadBalancerFrontendIpConfigurationRead,
+		Update: resourceArmLoadBalancerFrontendIpConfigurationCreateUpdate,
+		Delete: resourceArmLoadBalancerFrontendIpConfigurationDelete,
+		Importer: &schema.ResourceImporter{
+			State: loadBalancerSubResourceStateImporter,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"loadbalancer_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"subnet_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"private_ip_address": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validate.IPv4Address,
+			},
+
+			"public_ip_address_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: azure.ValidateResourceIDOrEmpty,
+			},
+
+			"private_ip_address_allocation": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(network.Dynamic),
+					string(network.Static),
+				}, true),
+				StateFunc:        ignoreCaseStateFunc,
+				DiffSuppressFunc: suppress.CaseDifference,
+			},
+
+			"load_balancer_rules": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+
+			"inbound_nat_rules": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+		},
+	}
+}
+
+func resourceArmLoadBalancerFrontendIpConfigurationCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).loadBalancerClient
+	ctx := meta.(*ArmClient).StopContext
+
+	loadBalancerID := d.Get("loadbalancer_id").(string)
+	name := d.Get("name").(string)
+
+	// queued rather than applied directly - see applyLoadBalancerWrite - so that this Frontend IP
+	// Configuration's write is coalesced with any other azurestack_lb_* sub-resource writes against
+	// the same LoadBalancer happening concurrently, into a single CreateOrUpdate of the parent
+	// LoadBalancer
+	read, err := applyLoadBalancerWrite(ctx, meta, loadBalancerID, func(loadBalancer *network.LoadBalancer) error {
+		newConfig := expandAzureRmLoadBalancerFrontendIpConfiguration(d)
+
+		configs := append(*loadBalancer.LoadBalancerPropertiesFormat.FrontendIPConfigurations, newConfig)
+
+		if _, exists := findLoadBalancerFrontEndIpConfigurationByName(loadBalancer, name); exists {
+			for i, config := range configs {
+				if config.Name != nil && *config.Name == name {
+					// this config is being updated/reapplied, remove the old copy from the slice
+					configs = append(configs[:i], configs[i+1:]...)
+					break
+				}
+			}
+		}
+
+		loadBalancer.LoadBalancerPropertiesFormat.FrontendIPConfigurations = &configs
+		return nil
+	})
+	if err != nil {
+		if _, exists, existsErr := retrieveLoadBalancerById(loadBalancerID, meta); existsErr == nil && !exists {
+			d.SetId("")
+			log.Printf("[INFO] LoadBalancer %q not found. Removing from state", name)
+			return nil
+		}
+
+		return fmt.Errorf("Error Creating/Updating LoadBalancer: %+v", err)
+	}
+
+	resGroup, loadBalancerName, err := resourceGroupAndLBNameFromId(loadBalancerID)
+	if err != nil {
+		return fmt.Errorf("Error parsing LoadBalancer Name and Group: %+v", err)
+	}
+
+	var configId string
+	for _, config := range *read.LoadBalancerPropertiesFormat.FrontendIPConfigurations {
+		if *config.Name == name {
+			configId = *config.ID
+		}
+	}
+
+	if configId == "" {
+		return fmt.Errorf("Cannot find created LoadBalancer Frontend IP Configuration ID %q", configId)
+	}
+
+	d.SetId(configId)
+
+	log.Printf("[DEBUG] Waiting for LoadBalancer (%s) to become available", loadBalancerName)
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"Accepted", "Updating"},
+		Target:  []string{"Succeeded"},
+		Refresh: loadbalancerStateRefreshFunc(ctx, client, resGroup, loadBalancerName),
+		Timeout: 10 * time.Minute,
+	}
+	if _, err := waitForStateContext(ctx, stateConf); err != nil {
+		return fmt.Errorf("Error waiting for LoadBalancer (%q Resource Group %q) to become available: %+v", loadBalancerName, resGroup, err)
+	}
+
+	return resourceArmLoadBalancerFrontendIpConfigurationRead(d, meta)
+}
+
+func resourceArmLoadBalancerFrontendIpConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	name := id.Path["frontendIPConfigurations"]
+
+	loadBalancer, exists, err := retrieveLoadBalancerById(d.Get("loadbalancer_id").(string), meta)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Load Balancer by ID: %+v", err)
+	}
+	if !exists {
+		d.SetId("")
+		log.Printf("[INFO] LoadBalancer %q not found. Removing from state", name)
+		return nil
+	}
+
+	config, exists := findLoadBalancerFrontEndIpConfigurationByName(loadBalancer, name)
+	if !exists {
+		d.SetId("")
+		log.Printf("[INFO] LoadBalancer Frontend IP Configuration %q not found. Removing from state", name)
+		return nil
+	}
+
+	d.Set("name", config.Name)
+	d.Set("resource_group_name", id.ResourceGroup)
+
+	if props := config.FrontendIPConfigurationPropertiesFormat; props != nil {
+		d.Set("private_ip_address_allocation", string(props.PrivateIPAllocationMethod))
+
+		if subnet := props.Subnet; subnet != nil {
+			d.Set("subnet_id", subnet.ID)
+		}
+
+		if pip := props.PrivateIPAddress; pip != nil {
+			d.Set("private_ip_address", *pip)
+		}
+
+		if pip := props.PublicIPAddress; pip != nil {
+			d.Set("public_ip_address_id", pip.ID)
+		}
+
+		var loadBalancingRules []string
+		if rules := props.LoadBalancingRules; rules != nil {
+			for _, rule := range *rules {
+				loadBalancingRules = append(loadBalancingRules, *rule.ID)
+			}
+		}
+		d.Set("load_balancer_rules", loadBalancingRules)
+
+		var inboundNatRules []string
+		if rules := props.InboundNatRules; rules != nil {
+			for _, rule := range *rules {
+				inboundNatRules = append(inboundNatRules, *rule.ID)
+			}
+		}
+		d.Set("inbound_nat_rules", inboundNatRules)
+	}
+
+	return nil
+}
+
+func resourceArmLoadBalancerFrontendIpConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
+	ctx := meta.(*ArmClient).StopContext
+
+	loadBalancerID := d.Get("loadbalancer_id").(string)
+	name := d.Get("name").(string)
+
+	_, err := applyLoadBalancerWrite(ctx, meta, loadBalancerID, func(loadBalancer *network.LoadBalancer) error {
+		_, exists := findLoadBalancerFrontEndIpConfigurationByName(loadBalancer, name)
+		if !exists {
+			return nil
+		}
+
+		configs := *loadBalancer.LoadBalancerPropertiesFormat.FrontendIPConfigurations
+		updated := make([]network.FrontendIPConfiguration, 0, len(configs))
+		for _, config := range configs {
+			if config.Name == nil || *config.Name != name {
+				updated = append(updated, config)
+			}
+		}
+
+		loadBalancer.LoadBalancerPropertiesFormat.FrontendIPConfigurations = &updated
+		return nil
+	})
+	if err != nil {
+		if _, exists, existsErr := retrieveLoadBalancerById(loadBalancerID, meta); existsErr == nil && !exists {
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error Creating/Updating LoadBalancer: %+v", err)
+	}
+
+	return nil
+}
+
+func expandAzureRmLoadBalancerFrontendIpConfiguration(d *schema.ResourceData) network.FrontendIPConfiguration {
+	properties := network.FrontendIPConfigurationPropertiesFormat{
+		PrivateIPAllocationMethod: network.IPAllocationMethod(d.Get("private_ip_address_allocation").(string)),
+	}
+
+	if v := d.Get("private_ip_address").(string); v != "" {
+		properties.PrivateIPAddress = &v
+	}
+
+	if v := d.Get("public_ip_address_id").(string); v != "" {
+		properties.PublicIPAddress = &network.PublicIPAddress{ID: &v}
+	}
+
+	if v := d.Get("subnet_id").(string); v != "" {
+		properties.Subnet = &network.Subnet{ID: &v}
+	}
+
+	return network.FrontendIPConfiguration{
+		Name:                                    utils.String(d.Get("name").(string)),
+		FrontendIPConfigurationPropertiesFormat: &properties,
+	}
+}
@@ -0,0 +1,97 @@
+package azurestack
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2017-10-01/network"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func TestAccAzureStackLoadBalancerFrontendIpConfiguration_basic(t *testing.T) {
+	var lb network.LoadBalancer
+	ri := acctest.RandInt()
+	frontendIpConfigName := fmt.Sprintf("%d-frontend-ip", ri)
+
+	subscriptionID := os.Getenv("ARM_SUBSCRIPTION_ID")
+	frontendIpConfigId := fmt.Sprintf(
+		"/subscriptions/%s/resourceGroups/acctestRG-%d/providers/Microsoft.Network/loadBalancers/arm-test-loadbalancer-%d/frontendIPConfigurations/%s",
+		subscriptionID, ri, ri, frontendIpConfigName)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureStackLoadBalancerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureStackLoadBalancerFrontendIpConfiguration_basic(ri, frontendIpConfigName, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureStackLoadBalancerExists("azurestack_lb.test", &lb),
+					testCheckAzureStackLoadBalancerFrontendIpConfigurationExists(frontendIpConfigName, &lb),
+					resource.TestCheckResourceAttr(
+						"azurestack_lb_frontend_ip_configuration.test", "id", frontendIpConfigId),
+				),
+			},
+			{
+				ResourceName:      "azurestack_lb_frontend_ip_configuration.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckAzureStackLoadBalancerFrontendIpConfigurationExists(frontendIpConfigName string, lb *network.LoadBalancer) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		_, exists := findLoadBalancerFrontEndIpConfigurationByName(lb, frontendIpConfigName)
+		if !exists {
+			return fmt.Errorf("A Frontend IP Configuration with name %q cannot be found.", frontendIpConfigName)
+		}
+
+		return nil
+	}
+}
+
+func testAccAzureStackLoadBalancerFrontendIpConfiguration_basic(rInt int, frontendIpConfigName string, location string) string {
+	return fmt.Sprintf(`
+resource "azurestack_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurestack_public_ip" "test" {
+  name                         = "test-ip-%d"
+  location                     = "${azurestack_resource_group.test.location}"
+  resource_group_name          = "${azurestack_resource_group.test.name}"
+  public_ip_address_allocation = "static"
+}
+
+resource "azurestack_public_ip" "test2" {
+  name                         = "test-ip2-%d"
+  location                     = "${azurestack_resource_group.test.location}"
+  resource_group_name          = "${azurestack_resource_group.test.name}"
+  public_ip_address_allocation = "static"
+}
+
+resource "azurestack_lb" "test" {
+  name                = "arm-test-loadbalancer-%d"
+  location            = "${azurestack_resource_group.test.location}"
+  resource_group_name = "${azurestack_resource_group.test.name}"
+
+  frontend_ip_configuration {
+    name                 = "one-%d"
+    public_ip_address_id = "${azurestack_public_ip.test.id}"
+  }
+}
+
+resource "azurestack_lb_frontend_ip_configuration" "test" {
+  resource_group_name  = "${azurestack_resource_group.test.name}"
+  loadbalancer_id      = "${azurestack_lb.test.id}"
+  name                 = "%s"
+  public_ip_address_id = "${azurestack_public_ip.test2.id}"
+}
+`, rInt, location, rInt, rInt, rInt, rInt, frontendIpConfigName)
+}
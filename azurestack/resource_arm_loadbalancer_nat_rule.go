@@ -24,6 +24,17 @@ func resourceArmLoadBalancerNatRule() *schema.Resource {
 			State: loadBalancerSubResourceStateImporter,
 		},
 
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			{
+				Type:    resourceArmLoadBalancerNatRuleResourceV0().CoreConfigSchema().ImpliedType(),
+				Upgrade: resourceArmLoadBalancerNatRuleStateUpgradeV0,
+				Version: 0,
+			},
+		},
+
+		CustomizeDiff: customizeDiffLoadBalancerFrontendPortCollision,
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -56,6 +67,13 @@ func resourceArmLoadBalancerNatRule() *schema.Resource {
 				Computed: true,
 			},
 
+			"idle_timeout_in_minutes": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(4, 30),
+			},
+
 			"frontend_port": {
 				Type:         schema.TypeInt,
 				Required:     true,
@@ -91,62 +109,48 @@ func resourceArmLoadBalancerNatRuleCreateUpdate(d *schema.ResourceData, meta int
 	ctx := meta.(*ArmClient).StopContext
 
 	loadBalancerID := d.Get("loadbalancer_id").(string)
-	armMutexKV.Lock(loadBalancerID)
-	defer armMutexKV.Unlock(loadBalancerID)
-
-	loadBalancer, exists, err := retrieveLoadBalancerById(loadBalancerID, meta)
-	if err != nil {
-		return errwrap.Wrapf("Error Getting LoadBalancer By ID {{err}}", err)
-	}
-	if !exists {
-		d.SetId("")
-		log.Printf("[INFO] LoadBalancer %q not found. Removing from state", d.Get("name").(string))
-		return nil
-	}
-
-	newNatRule, err := expandAzureRmLoadBalancerNatRule(d, loadBalancer)
-	if err != nil {
-		return errwrap.Wrapf("Error Expanding NAT Rule {{err}}", err)
-	}
+	name := d.Get("name").(string)
+
+	// queued rather than applied directly - see applyLoadBalancerWrite - so that this NAT Rule's
+	// write is coalesced with any other azurestack_lb_* sub-resource writes against the same
+	// LoadBalancer happening concurrently, into a single CreateOrUpdate of the parent LoadBalancer
+	read, err := applyLoadBalancerWrite(ctx, meta, loadBalancerID, func(loadBalancer *network.LoadBalancer) error {
+		newNatRule, err := expandAzureRmLoadBalancerNatRule(d, loadBalancer)
+		if err != nil {
+			return errwrap.Wrapf("Error Expanding NAT Rule {{err}}", err)
+		}
 
-	natRules := append(*loadBalancer.LoadBalancerPropertiesFormat.InboundNatRules, *newNatRule)
+		natRules := append(*loadBalancer.LoadBalancerPropertiesFormat.InboundNatRules, *newNatRule)
 
-	existingNatRule, existingNatRuleIndex, exists := findLoadBalancerNatRuleByName(loadBalancer, d.Get("name").(string))
-	if exists {
-		if d.Get("name").(string) == *existingNatRule.Name {
-			// this nat rule is being updated/reapplied remove old copy from the slice
-			natRules = append(natRules[:existingNatRuleIndex], natRules[existingNatRuleIndex+1:]...)
+		existingNatRule, existingNatRuleIndex, exists := findLoadBalancerNatRuleByName(loadBalancer, name)
+		if exists {
+			if name == *existingNatRule.Name {
+				// this nat rule is being updated/reapplied remove old copy from the slice
+				natRules = append(natRules[:existingNatRuleIndex], natRules[existingNatRuleIndex+1:]...)
+			}
 		}
-	}
-
-	loadBalancer.LoadBalancerPropertiesFormat.InboundNatRules = &natRules
-	resGroup, loadBalancerName, err := resourceGroupAndLBNameFromId(d.Get("loadbalancer_id").(string))
-	if err != nil {
-		return fmt.Errorf("Error Getting LoadBalancer Name and Group: %+v", err)
-	}
 
-	future, err := client.CreateOrUpdate(ctx, resGroup, loadBalancerName, *loadBalancer)
+		loadBalancer.LoadBalancerPropertiesFormat.InboundNatRules = &natRules
+		return nil
+	})
 	if err != nil {
-		return fmt.Errorf("Error Creating / Updating LoadBalancer %q (Resource Group %q): %+v", loadBalancerName, resGroup, err)
-	}
+		if _, exists, existsErr := retrieveLoadBalancerById(loadBalancerID, meta); existsErr == nil && !exists {
+			d.SetId("")
+			log.Printf("[INFO] LoadBalancer %q not found. Removing from state", name)
+			return nil
+		}
 
-	err = future.WaitForCompletionRef(ctx, client.Client)
-	if err != nil {
-		return fmt.Errorf("Error waiting for completion of Load Balancer %q (Resource Group %q): %+v", loadBalancerName, resGroup, err)
+		return fmt.Errorf("Error Creating / Updating LoadBalancer: %+v", err)
 	}
 
-	read, err := client.Get(ctx, resGroup, loadBalancerName, "")
+	resGroup, loadBalancerName, err := resourceGroupAndLBNameFromId(loadBalancerID)
 	if err != nil {
-		return fmt.Errorf("Error retrieving LoadBalancer %q (Resource Group %q): %+v", loadBalancerName, resGroup, err)
-	}
-
-	if read.ID == nil {
-		return fmt.Errorf("Cannot read LoadBalancer %q (Resource Group %q) ID", loadBalancerName, resGroup)
+		return fmt.Errorf("Error Getting LoadBalancer Name and Group: %+v", err)
 	}
 
 	var natRuleId string
-	for _, InboundNatRule := range *(*read.LoadBalancerPropertiesFormat).InboundNatRules {
-		if *InboundNatRule.Name == d.Get("name").(string) {
+	for _, InboundNatRule := range *read.LoadBalancerPropertiesFormat.InboundNatRules {
+		if *InboundNatRule.Name == name {
 			natRuleId = *InboundNatRule.ID
 		}
 	}
@@ -165,7 +169,7 @@ func resourceArmLoadBalancerNatRuleCreateUpdate(d *schema.ResourceData, meta int
 		Refresh: loadbalancerStateRefreshFunc(ctx, client, resGroup, loadBalancerName),
 		Timeout: 10 * time.Minute,
 	}
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := waitForStateContext(ctx, stateConf); err != nil {
 		return fmt.Errorf("Error waiting for LoadBalancer (%s) to become available: %s", loadBalancerName, err)
 	}
 
@@ -173,27 +177,27 @@ func resourceArmLoadBalancerNatRuleCreateUpdate(d *schema.ResourceData, meta int
 }
 
 func resourceArmLoadBalancerNatRuleRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).loadBalancerNatRuleClient
+	ctx := meta.(*ArmClient).StopContext
+
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
 		return err
 	}
 	name := id.Path["inboundNatRules"]
+	loadBalancerName := id.Path["loadBalancers"]
 
-	loadBalancer, exists, err := retrieveLoadBalancerById(d.Get("loadbalancer_id").(string), meta)
+	// only a targeted GET against this NAT Rule - not the entire parent LoadBalancer, which would
+	// mean a full LoadBalancer retrieval for every NAT Rule on it during a Refresh
+	config, err := client.Get(ctx, id.ResourceGroup, loadBalancerName, name, "")
 	if err != nil {
-		return fmt.Errorf("Error Getting LoadBalancer By ID: %+v", err)
-	}
-	if !exists {
-		d.SetId("")
-		log.Printf("[INFO] LoadBalancer %q not found. Removing from state", name)
-		return nil
-	}
+		if wasNotFound(config.Response) {
+			log.Printf("[INFO] LoadBalancer Nat Rule %q not found. Removing from state", name)
+			d.SetId("")
+			return nil
+		}
 
-	config, _, exists := findLoadBalancerNatRuleByName(loadBalancer, name)
-	if !exists {
-		d.SetId("")
-		log.Printf("[INFO] LoadBalancer Nat Rule %q not found. Removing from state", name)
-		return nil
+		return fmt.Errorf("Error retrieving LoadBalancer Nat Rule %q (LoadBalancer %q): %+v", name, loadBalancerName, err)
 	}
 
 	d.Set("name", config.Name)
@@ -205,6 +209,10 @@ func resourceArmLoadBalancerNatRuleRead(d *schema.ResourceData, meta interface{}
 		d.Set("backend_port", props.BackendPort)
 		d.Set("enable_floating_ip", props.EnableFloatingIP)
 
+		if props.IdleTimeoutInMinutes != nil {
+			d.Set("idle_timeout_in_minutes", props.IdleTimeoutInMinutes)
+		}
+
 		if ipconfiguration := props.FrontendIPConfiguration; ipconfiguration != nil {
 			fipID, err := parseAzureResourceID(*ipconfiguration.ID)
 			if err != nil {
@@ -224,55 +232,103 @@ func resourceArmLoadBalancerNatRuleRead(d *schema.ResourceData, meta interface{}
 }
 
 func resourceArmLoadBalancerNatRuleDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).loadBalancerClient
 	ctx := meta.(*ArmClient).StopContext
 
 	loadBalancerID := d.Get("loadbalancer_id").(string)
-	armMutexKV.Lock(loadBalancerID)
-	defer armMutexKV.Unlock(loadBalancerID)
+	name := d.Get("name").(string)
 
-	loadBalancer, exists, err := retrieveLoadBalancerById(loadBalancerID, meta)
-	if err != nil {
-		return fmt.Errorf("Error Getting LoadBalancer By ID: %+v", err)
-	}
-	if !exists {
-		d.SetId("")
-		return nil
-	}
+	_, err := applyLoadBalancerWrite(ctx, meta, loadBalancerID, func(loadBalancer *network.LoadBalancer) error {
+		_, index, exists := findLoadBalancerNatRuleByName(loadBalancer, name)
+		if !exists {
+			return nil
+		}
 
-	_, index, exists := findLoadBalancerNatRuleByName(loadBalancer, d.Get("name").(string))
-	if !exists {
+		oldNatRules := *loadBalancer.LoadBalancerPropertiesFormat.InboundNatRules
+		newNatRules := append(oldNatRules[:index], oldNatRules[index+1:]...)
+		loadBalancer.LoadBalancerPropertiesFormat.InboundNatRules = &newNatRules
 		return nil
+	})
+	if err != nil {
+		if _, exists, existsErr := retrieveLoadBalancerById(loadBalancerID, meta); existsErr == nil && !exists {
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error Creating/Updating LoadBalancer: %+v", err)
 	}
 
-	oldNatRules := *loadBalancer.LoadBalancerPropertiesFormat.InboundNatRules
-	newNatRules := append(oldNatRules[:index], oldNatRules[index+1:]...)
-	loadBalancer.LoadBalancerPropertiesFormat.InboundNatRules = &newNatRules
+	return nil
+}
 
-	resGroup, loadBalancerName, err := resourceGroupAndLBNameFromId(d.Get("loadbalancer_id").(string))
-	if err != nil {
-		return fmt.Errorf("Error Getting LoadBalancer Name and Group: %+v", err)
-	}
+// resourceArmLoadBalancerNatRuleResourceV0 describes the Schema as it existed before
+// `idle_timeout_in_minutes` was added, and is used only to decode state stored by that earlier
+// version of the Provider for resourceArmLoadBalancerNatRuleStateUpgradeV0.
+func resourceArmLoadBalancerNatRuleResourceV0() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
 
-	future, err := client.CreateOrUpdate(ctx, resGroup, loadBalancerName, *loadBalancer)
-	if err != nil {
-		return fmt.Errorf("Error Creating/Updating LoadBalancer %q (Resource Group %q) %+v", loadBalancerName, resGroup, err)
-	}
+			"resource_group_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
 
-	err = future.WaitForCompletionRef(ctx, client.Client)
-	if err != nil {
-		return fmt.Errorf("Error waiting for the completion of LoadBalancer updates for %q (Resource Group %q) %+v", loadBalancerName, resGroup, err)
-	}
+			"loadbalancer_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
 
-	read, err := client.Get(ctx, resGroup, loadBalancerName, "")
-	if err != nil {
-		return fmt.Errorf("Error retrieving LoadBalancer %q (Resource Group %q): %+v", loadBalancerName, resGroup, err)
+			"protocol": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"enable_floating_ip": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+
+			"frontend_port": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+
+			"backend_port": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+
+			"frontend_ip_configuration_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"frontend_ip_configuration_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"backend_ip_configuration_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
 	}
-	if read.ID == nil {
-		return fmt.Errorf("Cannot read LoadBalancer %q (resource group %q) ID", loadBalancerName, resGroup)
+}
+
+// resourceArmLoadBalancerNatRuleStateUpgradeV0 populates `idle_timeout_in_minutes` with the
+// service's own default for state stored before that field existed, so that upgrading the
+// Provider doesn't plan a spurious in-place update against existing NAT Rules on the next plan.
+func resourceArmLoadBalancerNatRuleStateUpgradeV0(rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+	if _, ok := rawState["idle_timeout_in_minutes"]; !ok {
+		rawState["idle_timeout_in_minutes"] = 4
 	}
 
-	return nil
+	return rawState, nil
 }
 
 func expandAzureRmLoadBalancerNatRule(d *schema.ResourceData, lb *network.LoadBalancer) (*network.InboundNatRule, error) {
@@ -287,6 +343,10 @@ func expandAzureRmLoadBalancerNatRule(d *schema.ResourceData, lb *network.LoadBa
 		properties.EnableFloatingIP = utils.Bool(v.(bool))
 	}
 
+	if v, ok := d.GetOk("idle_timeout_in_minutes"); ok {
+		properties.IdleTimeoutInMinutes = utils.Int32(int32(v.(int)))
+	}
+
 	if v := d.Get("frontend_ip_configuration_name").(string); v != "" {
 		rule, exists := findLoadBalancerFrontEndIpConfigurationByName(lb, v)
 		if !exists {
@@ -6,7 +6,6 @@ import (
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2017-10-01/network"
-	"github.com/hashicorp/errwrap"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
@@ -24,6 +23,10 @@ func resourceArmLoadBalancerProbe() *schema.Resource {
 			State: loadBalancerSubResourceStateImporter,
 		},
 
+		// `request_path` is only meaningful - and only accepted by the service - for HTTP probes, so
+		// catch a missing value at plan time rather than surfacing it as an ARM 400 at apply
+		CustomizeDiff: customizeDiffRequiredWithValue("protocol", string(network.ProbeProtocolHTTP), "request_path"),
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -68,13 +71,14 @@ func resourceArmLoadBalancerProbe() *schema.Resource {
 				Type:         schema.TypeInt,
 				Optional:     true,
 				Default:      15,
-				ValidateFunc: validation.IntAtLeast(5),
+				ValidateFunc: validation.IntBetween(5, 3600),
 			},
 
 			"number_of_probes": {
-				Type:     schema.TypeInt,
-				Optional: true,
-				Default:  2,
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      2,
+				ValidateFunc: validation.IntAtLeast(1),
 			},
 
 			"load_balancer_rules": {
@@ -92,57 +96,44 @@ func resourceArmLoadBalancerProbeCreateUpdate(d *schema.ResourceData, meta inter
 	ctx := meta.(*ArmClient).StopContext
 
 	loadBalancerID := d.Get("loadbalancer_id").(string)
-	armMutexKV.Lock(loadBalancerID)
-	defer armMutexKV.Unlock(loadBalancerID)
-
-	loadBalancer, exists, err := retrieveLoadBalancerById(loadBalancerID, meta)
-	if err != nil {
-		return fmt.Errorf("Error Getting LoadBalancer By ID: %+v", err)
-	}
-	if !exists {
-		d.SetId("")
-		log.Printf("[INFO] LoadBalancer %q not found. Removing from state", d.Get("name").(string))
-		return nil
-	}
-
-	newProbe := expandAzureRmLoadBalancerProbe(d)
-	probes := append(*loadBalancer.LoadBalancerPropertiesFormat.Probes, *newProbe)
-
-	existingProbe, existingProbeIndex, exists := findLoadBalancerProbeByName(loadBalancer, d.Get("name").(string))
-	if exists {
-		if d.Get("name").(string) == *existingProbe.Name {
-			// this probe is being updated/reapplied remove old copy from the slice
-			probes = append(probes[:existingProbeIndex], probes[existingProbeIndex+1:]...)
+	name := d.Get("name").(string)
+
+	// queued rather than applied directly - see applyLoadBalancerWrite - so that this Probe's write
+	// is coalesced with any other azurestack_lb_* sub-resource writes against the same LoadBalancer
+	// happening concurrently, into a single CreateOrUpdate of the parent LoadBalancer
+	read, err := applyLoadBalancerWrite(ctx, meta, loadBalancerID, func(loadBalancer *network.LoadBalancer) error {
+		newProbe := expandAzureRmLoadBalancerProbe(d)
+		probes := append(*loadBalancer.LoadBalancerPropertiesFormat.Probes, *newProbe)
+
+		existingProbe, existingProbeIndex, exists := findLoadBalancerProbeByName(loadBalancer, name)
+		if exists {
+			if name == *existingProbe.Name {
+				// this probe is being updated/reapplied remove old copy from the slice
+				probes = append(probes[:existingProbeIndex], probes[existingProbeIndex+1:]...)
+			}
 		}
-	}
 
-	loadBalancer.LoadBalancerPropertiesFormat.Probes = &probes
-	resGroup, loadBalancerName, err := resourceGroupAndLBNameFromId(d.Get("loadbalancer_id").(string))
-	if err != nil {
-		return fmt.Errorf("Error Getting LoadBalancer Name and Group: %+v", err)
-	}
-
-	future, err := client.CreateOrUpdate(ctx, resGroup, loadBalancerName, *loadBalancer)
+		loadBalancer.LoadBalancerPropertiesFormat.Probes = &probes
+		return nil
+	})
 	if err != nil {
-		return fmt.Errorf("Error Creating/Updating Load Balancer %q (Resource Group %q): %+v", loadBalancerName, resGroup, err)
-	}
+		if _, exists, existsErr := retrieveLoadBalancerById(loadBalancerID, meta); existsErr == nil && !exists {
+			d.SetId("")
+			log.Printf("[INFO] LoadBalancer %q not found. Removing from state", name)
+			return nil
+		}
 
-	err = future.WaitForCompletionRef(ctx, client.Client)
-	if err != nil {
-		return fmt.Errorf("Error waiting for completion of Load Balancer %q (Resource Group %q): %+v", loadBalancerName, resGroup, err)
+		return fmt.Errorf("Error Creating/Updating Load Balancer: %+v", err)
 	}
 
-	read, err := client.Get(ctx, resGroup, loadBalancerName, "")
+	resGroup, loadBalancerName, err := resourceGroupAndLBNameFromId(loadBalancerID)
 	if err != nil {
-		return fmt.Errorf("Error retrieving Load Balancer %q (Resource Group %q): %+v", loadBalancerName, resGroup, err)
-	}
-	if read.ID == nil {
-		return fmt.Errorf("Cannot read LoadBalancer %q (resource group %q) ID", loadBalancerName, resGroup)
+		return fmt.Errorf("Error Getting LoadBalancer Name and Group: %+v", err)
 	}
 
 	var createdProbeId string
-	for _, Probe := range *(*read.LoadBalancerPropertiesFormat).Probes {
-		if *Probe.Name == d.Get("name").(string) {
+	for _, Probe := range *read.LoadBalancerPropertiesFormat.Probes {
+		if *Probe.Name == name {
 			createdProbeId = *Probe.ID
 		}
 	}
@@ -160,7 +151,7 @@ func resourceArmLoadBalancerProbeCreateUpdate(d *schema.ResourceData, meta inter
 		Refresh: loadbalancerStateRefreshFunc(ctx, client, resGroup, loadBalancerName),
 		Timeout: 10 * time.Minute,
 	}
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := waitForStateContext(ctx, stateConf); err != nil {
 		return fmt.Errorf("Error waiting for LoadBalancer (%q - Resource Group %q) to become available: %+v", loadBalancerName, resGroup, err)
 	}
 
@@ -168,27 +159,27 @@ func resourceArmLoadBalancerProbeCreateUpdate(d *schema.ResourceData, meta inter
 }
 
 func resourceArmLoadBalancerProbeRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).loadBalancerProbeClient
+	ctx := meta.(*ArmClient).StopContext
+
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
 		return err
 	}
 	name := id.Path["probes"]
+	loadBalancerName := id.Path["loadBalancers"]
 
-	loadBalancer, exists, err := retrieveLoadBalancerById(d.Get("loadbalancer_id").(string), meta)
+	// only a targeted GET against this Probe - not the entire parent LoadBalancer, which would mean
+	// a full LoadBalancer retrieval for every Probe on it during a Refresh
+	config, err := client.Get(ctx, id.ResourceGroup, loadBalancerName, name)
 	if err != nil {
-		return errwrap.Wrapf("Error Getting LoadBalancer By ID {{err}}", err)
-	}
-	if !exists {
-		d.SetId("")
-		log.Printf("[INFO] LoadBalancer %q not found. Removing from state", name)
-		return nil
-	}
+		if wasNotFound(config.Response) {
+			log.Printf("[INFO] LoadBalancer Probe %q not found. Removing from state", name)
+			d.SetId("")
+			return nil
+		}
 
-	config, _, exists := findLoadBalancerProbeByName(loadBalancer, name)
-	if !exists {
-		d.SetId("")
-		log.Printf("[INFO] LoadBalancer Probe %q not found. Removing from state", name)
-		return nil
+		return fmt.Errorf("Error retrieving LoadBalancer Probe %q (LoadBalancer %q): %+v", name, loadBalancerName, err)
 	}
 
 	d.Set("name", config.Name)
@@ -214,52 +205,29 @@ func resourceArmLoadBalancerProbeRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceArmLoadBalancerProbeDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).loadBalancerClient
 	ctx := meta.(*ArmClient).StopContext
 
 	loadBalancerID := d.Get("loadbalancer_id").(string)
-	armMutexKV.Lock(loadBalancerID)
-	defer armMutexKV.Unlock(loadBalancerID)
+	name := d.Get("name").(string)
 
-	loadBalancer, exists, err := retrieveLoadBalancerById(loadBalancerID, meta)
-	if err != nil {
-		return fmt.Errorf("Error Getting LoadBalancer By ID: %+v", err)
-	}
-	if !exists {
-		d.SetId("")
-		return nil
-	}
+	_, err := applyLoadBalancerWrite(ctx, meta, loadBalancerID, func(loadBalancer *network.LoadBalancer) error {
+		_, index, exists := findLoadBalancerProbeByName(loadBalancer, name)
+		if !exists {
+			return nil
+		}
 
-	_, index, exists := findLoadBalancerProbeByName(loadBalancer, d.Get("name").(string))
-	if !exists {
+		oldProbes := *loadBalancer.LoadBalancerPropertiesFormat.Probes
+		newProbes := append(oldProbes[:index], oldProbes[index+1:]...)
+		loadBalancer.LoadBalancerPropertiesFormat.Probes = &newProbes
 		return nil
-	}
-
-	oldProbes := *loadBalancer.LoadBalancerPropertiesFormat.Probes
-	newProbes := append(oldProbes[:index], oldProbes[index+1:]...)
-	loadBalancer.LoadBalancerPropertiesFormat.Probes = &newProbes
-
-	resGroup, loadBalancerName, err := resourceGroupAndLBNameFromId(d.Get("loadbalancer_id").(string))
-	if err != nil {
-		return errwrap.Wrapf("Error Getting LoadBalancer Name and Group: {{err}}", err)
-	}
-
-	future, err := client.CreateOrUpdate(ctx, resGroup, loadBalancerName, *loadBalancer)
-	if err != nil {
-		return fmt.Errorf("Error Creating/Updating LoadBalancer %q (Resource Group %q): %+v", loadBalancerName, resGroup, err)
-	}
-
-	err = future.WaitForCompletionRef(ctx, client.Client)
+	})
 	if err != nil {
-		return fmt.Errorf("Error waiting for completion of LoadBalancer %q (Resource Group %q): %+v", loadBalancerName, resGroup, err)
-	}
+		if _, exists, existsErr := retrieveLoadBalancerById(loadBalancerID, meta); existsErr == nil && !exists {
+			d.SetId("")
+			return nil
+		}
 
-	read, err := client.Get(ctx, resGroup, loadBalancerName, "")
-	if err != nil {
-		return errwrap.Wrapf("Error Getting LoadBalancer {{err}}", err)
-	}
-	if read.ID == nil {
-		return fmt.Errorf("Cannot read LoadBalancer %s (resource group %s) ID", loadBalancerName, resGroup)
+		return fmt.Errorf("Error Creating/Updating LoadBalancer: %+v", err)
 	}
 
 	return nil
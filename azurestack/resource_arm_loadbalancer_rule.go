@@ -105,9 +105,22 @@ func resourceArmLoadBalancerRule() *schema.Resource {
 			},
 
 			"load_distribution": {
-				Type:     schema.TypeString,
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				StateFunc:        ignoreCaseStateFunc,
+				DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(network.Default),
+					string(network.SourceIP),
+					string(network.SourceIPProtocol),
+				}, true),
+			},
+
+			"disable_outbound_snat": {
+				Type:     schema.TypeBool,
 				Optional: true,
-				Computed: true,
+				Default:  false,
 			},
 		},
 	}
@@ -118,61 +131,48 @@ func resourceArmLoadBalancerRuleCreateUpdate(d *schema.ResourceData, meta interf
 	ctx := meta.(*ArmClient).StopContext
 
 	loadBalancerID := d.Get("loadbalancer_id").(string)
-	armMutexKV.Lock(loadBalancerID)
-	defer armMutexKV.Unlock(loadBalancerID)
-
-	loadBalancer, exists, err := retrieveLoadBalancerById(loadBalancerID, meta)
-	if err != nil {
-		return errwrap.Wrapf("Error Getting LoadBalancer By ID {{err}}", err)
-	}
-	if !exists {
-		d.SetId("")
-		log.Printf("[INFO] LoadBalancer %q not found. Removing from state", d.Get("name").(string))
-		return nil
-	}
-
-	newLbRule, err := expandAzureRmLoadBalancerRule(d, loadBalancer)
-	if err != nil {
-		return errwrap.Wrapf("Error Exanding LoadBalancer Rule {{err}}", err)
-	}
+	name := d.Get("name").(string)
+
+	// queued rather than applied directly - see applyLoadBalancerWrite - so that this Rule's write
+	// is coalesced with any other azurestack_lb_* sub-resource writes against the same LoadBalancer
+	// happening concurrently, into a single CreateOrUpdate of the parent LoadBalancer
+	read, err := applyLoadBalancerWrite(ctx, meta, loadBalancerID, func(loadBalancer *network.LoadBalancer) error {
+		newLbRule, err := expandAzureRmLoadBalancerRule(d, loadBalancer)
+		if err != nil {
+			return errwrap.Wrapf("Error Exanding LoadBalancer Rule {{err}}", err)
+		}
 
-	lbRules := append(*loadBalancer.LoadBalancerPropertiesFormat.LoadBalancingRules, *newLbRule)
+		lbRules := append(*loadBalancer.LoadBalancerPropertiesFormat.LoadBalancingRules, *newLbRule)
 
-	existingRule, existingRuleIndex, exists := findLoadBalancerRuleByName(loadBalancer, d.Get("name").(string))
-	if exists {
-		if d.Get("name").(string) == *existingRule.Name {
-			// this rule is being updated/reapplied remove old copy from the slice
-			lbRules = append(lbRules[:existingRuleIndex], lbRules[existingRuleIndex+1:]...)
+		existingRule, existingRuleIndex, exists := findLoadBalancerRuleByName(loadBalancer, name)
+		if exists {
+			if name == *existingRule.Name {
+				// this rule is being updated/reapplied remove old copy from the slice
+				lbRules = append(lbRules[:existingRuleIndex], lbRules[existingRuleIndex+1:]...)
+			}
 		}
-	}
 
-	loadBalancer.LoadBalancerPropertiesFormat.LoadBalancingRules = &lbRules
-	resGroup, loadBalancerName, err := resourceGroupAndLBNameFromId(d.Get("loadbalancer_id").(string))
+		loadBalancer.LoadBalancerPropertiesFormat.LoadBalancingRules = &lbRules
+		return nil
+	})
 	if err != nil {
-		return errwrap.Wrapf("Error Getting LoadBalancer Name and Group: {{err}}", err)
-	}
+		if _, exists, existsErr := retrieveLoadBalancerById(loadBalancerID, meta); existsErr == nil && !exists {
+			d.SetId("")
+			log.Printf("[INFO] LoadBalancer %q not found. Removing from state", name)
+			return nil
+		}
 
-	future, err := client.CreateOrUpdate(ctx, resGroup, loadBalancerName, *loadBalancer)
-	if err != nil {
 		return errwrap.Wrapf("Error Creating/Updating LoadBalancer {{err}}", err)
 	}
 
-	err = future.WaitForCompletionRef(ctx, client.Client)
-	if err != nil {
-		return fmt.Errorf("Error waiting for completion for LoadBalancer updates: %+v", err)
-	}
-
-	read, err := client.Get(ctx, resGroup, loadBalancerName, "")
+	resGroup, loadBalancerName, err := resourceGroupAndLBNameFromId(loadBalancerID)
 	if err != nil {
-		return errwrap.Wrapf("Error Getting LoadBalancer {{err}}", err)
-	}
-	if read.ID == nil {
-		return fmt.Errorf("Cannot read LoadBalancer %s (resource group %s) ID", loadBalancerName, resGroup)
+		return errwrap.Wrapf("Error Getting LoadBalancer Name and Group: {{err}}", err)
 	}
 
 	var ruleId string
-	for _, LoadBalancingRule := range *(*read.LoadBalancerPropertiesFormat).LoadBalancingRules {
-		if *LoadBalancingRule.Name == d.Get("name").(string) {
+	for _, LoadBalancingRule := range *read.LoadBalancerPropertiesFormat.LoadBalancingRules {
+		if *LoadBalancingRule.Name == name {
 			ruleId = *LoadBalancingRule.ID
 		}
 	}
@@ -190,7 +190,7 @@ func resourceArmLoadBalancerRuleCreateUpdate(d *schema.ResourceData, meta interf
 		Refresh: loadbalancerStateRefreshFunc(ctx, client, resGroup, loadBalancerName),
 		Timeout: 10 * time.Minute,
 	}
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := waitForStateContext(ctx, stateConf); err != nil {
 		return fmt.Errorf("Error waiting for LoadBalancer (%s) to become available: %s", loadBalancerName, err)
 	}
 
@@ -198,27 +198,27 @@ func resourceArmLoadBalancerRuleCreateUpdate(d *schema.ResourceData, meta interf
 }
 
 func resourceArmLoadBalancerRuleRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).loadBalancerRuleClient
+	ctx := meta.(*ArmClient).StopContext
+
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
 		return err
 	}
 	name := id.Path["loadBalancingRules"]
+	loadBalancerName := id.Path["loadBalancers"]
 
-	loadBalancer, exists, err := retrieveLoadBalancerById(d.Get("loadbalancer_id").(string), meta)
+	// only a targeted GET against this Rule - not the entire parent LoadBalancer, which would mean a
+	// full LoadBalancer retrieval for every Rule on it during a Refresh
+	config, err := client.Get(ctx, id.ResourceGroup, loadBalancerName, name)
 	if err != nil {
-		return errwrap.Wrapf("Error Getting LoadBalancer By ID {{err}}", err)
-	}
-	if !exists {
-		d.SetId("")
-		log.Printf("[INFO] LoadBalancer %q not found. Removing from state", name)
-		return nil
-	}
+		if wasNotFound(config.Response) {
+			log.Printf("[INFO] LoadBalancer Rule %q not found. Removing from state", name)
+			d.SetId("")
+			return nil
+		}
 
-	config, _, exists := findLoadBalancerRuleByName(loadBalancer, name)
-	if !exists {
-		d.SetId("")
-		log.Printf("[INFO] LoadBalancer Rule %q not found. Removing from state", name)
-		return nil
+		return fmt.Errorf("Error retrieving LoadBalancer Rule %q (LoadBalancer %q): %+v", name, loadBalancerName, err)
 	}
 
 	d.Set("name", config.Name)
@@ -258,58 +258,37 @@ func resourceArmLoadBalancerRuleRead(d *schema.ResourceData, meta interface{}) e
 		if properties.LoadDistribution != "" {
 			d.Set("load_distribution", properties.LoadDistribution)
 		}
+
+		d.Set("disable_outbound_snat", boolValue(properties.DisableOutboundSnat))
 	}
 
 	return nil
 }
 
 func resourceArmLoadBalancerRuleDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).loadBalancerClient
 	ctx := meta.(*ArmClient).StopContext
 
 	loadBalancerID := d.Get("loadbalancer_id").(string)
-	armMutexKV.Lock(loadBalancerID)
-	defer armMutexKV.Unlock(loadBalancerID)
+	name := d.Get("name").(string)
 
-	loadBalancer, exists, err := retrieveLoadBalancerById(loadBalancerID, meta)
-	if err != nil {
-		return errwrap.Wrapf("Error Getting LoadBalancer By ID {{err}}", err)
-	}
-	if !exists {
-		d.SetId("")
-		return nil
-	}
+	_, err := applyLoadBalancerWrite(ctx, meta, loadBalancerID, func(loadBalancer *network.LoadBalancer) error {
+		_, index, exists := findLoadBalancerRuleByName(loadBalancer, name)
+		if !exists {
+			return nil
+		}
 
-	_, index, exists := findLoadBalancerRuleByName(loadBalancer, d.Get("name").(string))
-	if !exists {
+		oldLbRules := *loadBalancer.LoadBalancerPropertiesFormat.LoadBalancingRules
+		newLbRules := append(oldLbRules[:index], oldLbRules[index+1:]...)
+		loadBalancer.LoadBalancerPropertiesFormat.LoadBalancingRules = &newLbRules
 		return nil
-	}
-
-	oldLbRules := *loadBalancer.LoadBalancerPropertiesFormat.LoadBalancingRules
-	newLbRules := append(oldLbRules[:index], oldLbRules[index+1:]...)
-	loadBalancer.LoadBalancerPropertiesFormat.LoadBalancingRules = &newLbRules
-
-	resGroup, loadBalancerName, err := resourceGroupAndLBNameFromId(d.Get("loadbalancer_id").(string))
-	if err != nil {
-		return errwrap.Wrapf("Error Getting LoadBalancer Name and Group: {{err}}", err)
-	}
-
-	future, err := client.CreateOrUpdate(ctx, resGroup, loadBalancerName, *loadBalancer)
+	})
 	if err != nil {
-		return fmt.Errorf("Error Creating/Updating LoadBalancer %q (Resource Group %q): %+v", loadBalancerName, resGroup, err)
-	}
-
-	err = future.WaitForCompletionRef(ctx, client.Client)
-	if err != nil {
-		return fmt.Errorf("Error waiting for completion of LoadBalancer %q (Resource Group %q): %+v", loadBalancerName, resGroup, err)
-	}
+		if _, exists, existsErr := retrieveLoadBalancerById(loadBalancerID, meta); existsErr == nil && !exists {
+			d.SetId("")
+			return nil
+		}
 
-	read, err := client.Get(ctx, resGroup, loadBalancerName, "")
-	if err != nil {
-		return errwrap.Wrapf("Error Getting LoadBalancer {{err}}", err)
-	}
-	if read.ID == nil {
-		return fmt.Errorf("Cannot read ID of LoadBalancer %q (resource group %s)", loadBalancerName, resGroup)
+		return errwrap.Wrapf("Error Creating/Updating LoadBalancer {{err}}", err)
 	}
 
 	return nil
@@ -355,6 +334,8 @@ func expandAzureRmLoadBalancerRule(d *schema.ResourceData, lb *network.LoadBalan
 		}
 	}
 
+	properties.DisableOutboundSnat = utils.Bool(d.Get("disable_outbound_snat").(bool))
+
 	return &network.LoadBalancingRule{
 		Name:                              utils.String(d.Get("name").(string)),
 		LoadBalancingRulePropertiesFormat: &properties,
@@ -8,7 +8,6 @@ import (
 	"github.com/Azure/azure-sdk-for-go/profiles/2019-03-01/compute/mgmt/compute"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -23,6 +22,8 @@ func resourceArmManagedDisk() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: customizeDiffTagsWithDefaultTags,
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -117,7 +118,7 @@ func resourceArmManagedDiskCreateUpdate(d *schema.ResourceData, meta interface{}
 	storageAccountType := d.Get("storage_account_type").(string)
 	osType := d.Get("os_type").(string)
 	tags := d.Get("tags").(map[string]interface{})
-	expandedTags := expandTags(tags)
+	expandedTags := expandTags(meta, tags)
 
 	createDisk := compute.Disk{
 		Name:     &name,
@@ -198,7 +199,7 @@ func resourceArmManagedDiskRead(d *schema.ResourceData, meta interface{}) error
 
 	resp, err := client.Get(ctx, resGroup, name)
 	if err != nil {
-		if utils.ResponseWasNotFound(resp.Response) {
+		if wasNotFound(resp.Response) {
 			d.SetId("")
 			return nil
 		}
@@ -246,13 +247,13 @@ func resourceArmManagedDiskDelete(d *schema.ResourceData, meta interface{}) erro
 
 	future, err := client.Delete(ctx, resGroup, name)
 	if err != nil {
-		if !response.WasNotFound(future.Response()) {
+		if !wasNotFoundRaw(future.Response()) {
 			return err
 		}
 	}
 
 	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		if !response.WasNotFound(future.Response()) {
+		if !wasNotFoundRaw(future.Response()) {
 			return err
 		}
 	}
@@ -263,7 +264,7 @@ func resourceArmManagedDiskDelete(d *schema.ResourceData, meta interface{}) erro
 func flattenAzureRmManagedDiskCreationData(d *schema.ResourceData, creationData *compute.CreationData) {
 	d.Set("create_option", string(creationData.CreateOption))
 	if ref := creationData.ImageReference; ref != nil {
-		d.Set("image_reference_id", *ref.ID)
+		d.Set("image_reference_id", stringValue(ref.ID))
 	}
 	if id := creationData.SourceResourceID; id != nil {
 		d.Set("source_resource_id", *id)
@@ -12,6 +12,8 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
+var networkInterfaceResourceName = "azurestack_network_interface"
+
 func resourceArmNetworkInterface() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceArmNetworkInterfaceCreateUpdate,
@@ -22,6 +24,8 @@ func resourceArmNetworkInterface() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: customizeDiffTagsWithDefaultTags,
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -228,7 +232,9 @@ func resourceArmNetworkInterfaceCreateUpdate(d *schema.ResourceData, meta interf
 			return err
 		}
 
-		azureStackLockByName(networkSecurityGroupName, networkSecurityGroupResourceName)
+		if err := azureStackLockByName(networkSecurityGroupName, networkSecurityGroupResourceName); err != nil {
+			return err
+		}
 		defer azureStackUnlockByName(networkSecurityGroupName, networkSecurityGroupResourceName)
 	}
 
@@ -265,10 +271,14 @@ func resourceArmNetworkInterfaceCreateUpdate(d *schema.ResourceData, meta interf
 		return fmt.Errorf("Error Building list of Network Interface IP Configurations: %+v", sgErr)
 	}
 
-	azureStackLockMultipleByName(subnetnToLock, subnetResourceName)
+	if err := azureStackLockMultipleByName(subnetnToLock, subnetResourceName); err != nil {
+		return err
+	}
 	defer azureStackUnlockMultipleByName(subnetnToLock, subnetResourceName)
 
-	azureStackLockMultipleByName(vnnToLock, virtualNetworkResourceName)
+	if err := azureStackLockMultipleByName(vnnToLock, virtualNetworkResourceName); err != nil {
+		return err
+	}
 	defer azureStackUnlockMultipleByName(vnnToLock, virtualNetworkResourceName)
 
 	if len(ipConfigs) > 0 {
@@ -279,7 +289,7 @@ func resourceArmNetworkInterfaceCreateUpdate(d *schema.ResourceData, meta interf
 		Name:                      &name,
 		Location:                  &location,
 		InterfacePropertiesFormat: &properties,
-		Tags:                      *expandTags(tags),
+		Tags:                      *expandTags(meta, tags),
 	}
 
 	data, _ := json.Marshal(&iface)
@@ -320,7 +330,7 @@ func resourceArmNetworkInterfaceRead(d *schema.ResourceData, meta interface{}) e
 
 	resp, err := client.Get(ctx, resGroup, name, "")
 	if err != nil {
-		if utils.ResponseWasNotFound(resp.Response) {
+		if wasNotFound(resp.Response) {
 			d.SetId("")
 			return nil
 		}
@@ -410,7 +420,9 @@ func resourceArmNetworkInterfaceDelete(d *schema.ResourceData, meta interface{})
 			return err
 		}
 
-		azureStackLockByName(networkSecurityGroupName, networkSecurityGroupResourceName)
+		if err := azureStackLockByName(networkSecurityGroupName, networkSecurityGroupResourceName); err != nil {
+			return err
+		}
 		defer azureStackUnlockByName(networkSecurityGroupName, networkSecurityGroupResourceName)
 	}
 
@@ -437,10 +449,14 @@ func resourceArmNetworkInterfaceDelete(d *schema.ResourceData, meta interface{})
 		}
 	}
 
-	azureStackLockMultipleByName(&subnetNamesToLock, subnetResourceName)
+	if err := azureStackLockMultipleByName(&subnetNamesToLock, subnetResourceName); err != nil {
+		return err
+	}
 	defer azureStackUnlockMultipleByName(&subnetNamesToLock, subnetResourceName)
 
-	azureStackLockMultipleByName(&virtualNetworkNamesToLock, virtualNetworkResourceName)
+	if err := azureStackLockMultipleByName(&virtualNetworkNamesToLock, virtualNetworkResourceName); err != nil {
+		return err
+	}
 	defer azureStackUnlockMultipleByName(&virtualNetworkNamesToLock, virtualNetworkResourceName)
 
 	future, err := client.Delete(ctx, resGroup, name)
@@ -0,0 +1,246 @@
+package azurestack
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/2019-03-01/network/mgmt/network"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+	"github.com/terraform-providers/terraform-provider-azurestack/azurestack/helpers/azure"
+)
+
+func resourceArmNetworkInterfaceApplicationSecurityGroupAssociation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmNetworkInterfaceApplicationSecurityGroupAssociationCreate,
+		Read:   resourceArmNetworkInterfaceApplicationSecurityGroupAssociationRead,
+		Delete: resourceArmNetworkInterfaceApplicationSecurityGroupAssociationDelete,
+
+		Schema: map[string]*schema.Schema{
+			"network_interface_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"ip_configuration_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"application_security_group_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+		},
+	}
+}
+
+// networkInterfaceApplicationSecurityGroupAssociationID is a synthetic, non-ARM ID - there's no ARM
+// sub-resource representing this association, so its identity is defined here as the tuple that
+// locates it inside the parent Network Interface's IP Configuration.
+func networkInterfaceApplicationSecurityGroupAssociationID(nicID, ipConfigName, applicationSecurityGroupID string) string {
+	return strings.Join([]string{nicID, ipConfigName, applicationSecurityGroupID}, "|")
+}
+
+func parseNetworkInterfaceApplicationSecurityGroupAssociationID(id string) (nicID, ipConfigName, applicationSecurityGroupID string, err error) {
+	parts := strings.Split(id, "|")
+	if len(parts) != 3 {
+		return "", "", "", fmt.Errorf("Error parsing Network Interface Application Security Group Association ID %q: expected 3 `|`-separated segments", id)
+	}
+
+	return parts[0], parts[1], parts[2], nil
+}
+
+func resourceArmNetworkInterfaceApplicationSecurityGroupAssociationCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).ifaceClient
+	ctx := meta.(*ArmClient).StopContext
+
+	nicID := d.Get("network_interface_id").(string)
+	ipConfigName := d.Get("ip_configuration_name").(string)
+	applicationSecurityGroupID := d.Get("application_security_group_id").(string)
+
+	id, err := parseAzureResourceID(nicID)
+	if err != nil {
+		return err
+	}
+	resGroup := id.ResourceGroup
+	nicName := id.Path["networkInterfaces"]
+
+	if err := azureStackLockByName(nicName, networkInterfaceResourceName); err != nil {
+		return err
+	}
+	defer azureStackUnlockByName(nicName, networkInterfaceResourceName)
+
+	// this association has no ARM sub-resource of its own - it's a reference held inside the parent
+	// Network Interface's IP Configuration - so, unlike the LoadBalancer sub-resources, there's no
+	// per-write endpoint to target: the whole Network Interface has to be read back, modified in
+	// place and written back
+	iface, err := client.Get(ctx, resGroup, nicName, "")
+	if err != nil {
+		return fmt.Errorf("Error retrieving Network Interface %q (Resource Group %q): %+v", nicName, resGroup, err)
+	}
+
+	ipConfig, err := findNetworkInterfaceIPConfiguration(&iface, ipConfigName)
+	if err != nil {
+		return err
+	}
+
+	ipConfig.ApplicationSecurityGroups = addApplicationSecurityGroupID(ipConfig.ApplicationSecurityGroups, applicationSecurityGroupID)
+
+	future, err := client.CreateOrUpdate(ctx, resGroup, nicName, iface)
+	if err != nil {
+		return fmt.Errorf("Error updating Network Interface %q (Resource Group %q): %+v", nicName, resGroup, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for update of Network Interface %q (Resource Group %q): %+v", nicName, resGroup, err)
+	}
+
+	d.SetId(networkInterfaceApplicationSecurityGroupAssociationID(nicID, ipConfigName, applicationSecurityGroupID))
+
+	return resourceArmNetworkInterfaceApplicationSecurityGroupAssociationRead(d, meta)
+}
+
+func resourceArmNetworkInterfaceApplicationSecurityGroupAssociationRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).ifaceClient
+	ctx := meta.(*ArmClient).StopContext
+
+	nicID, ipConfigName, applicationSecurityGroupID, err := parseNetworkInterfaceApplicationSecurityGroupAssociationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	id, err := parseAzureResourceID(nicID)
+	if err != nil {
+		return err
+	}
+	resGroup := id.ResourceGroup
+	nicName := id.Path["networkInterfaces"]
+
+	iface, err := client.Get(ctx, resGroup, nicName, "")
+	if err != nil {
+		if wasNotFound(iface.Response) {
+			log.Printf("[INFO] Network Interface %q not found. Removing from state", nicName)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Network Interface %q (Resource Group %q): %+v", nicName, resGroup, err)
+	}
+
+	ipConfig, err := findNetworkInterfaceIPConfiguration(&iface, ipConfigName)
+	if err != nil {
+		log.Printf("[INFO] %s. Removing Network Interface Application Security Group Association from state", err)
+		d.SetId("")
+		return nil
+	}
+
+	if !hasApplicationSecurityGroupID(ipConfig.ApplicationSecurityGroups, applicationSecurityGroupID) {
+		log.Printf("[INFO] Application Security Group %q is no longer associated. Removing Network Interface Application Security Group Association from state", applicationSecurityGroupID)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("network_interface_id", nicID)
+	d.Set("ip_configuration_name", ipConfigName)
+	d.Set("application_security_group_id", applicationSecurityGroupID)
+
+	return nil
+}
+
+func resourceArmNetworkInterfaceApplicationSecurityGroupAssociationDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).ifaceClient
+	ctx := meta.(*ArmClient).StopContext
+
+	nicID, ipConfigName, applicationSecurityGroupID, err := parseNetworkInterfaceApplicationSecurityGroupAssociationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	id, err := parseAzureResourceID(nicID)
+	if err != nil {
+		return err
+	}
+	resGroup := id.ResourceGroup
+	nicName := id.Path["networkInterfaces"]
+
+	if err := azureStackLockByName(nicName, networkInterfaceResourceName); err != nil {
+		return err
+	}
+	defer azureStackUnlockByName(nicName, networkInterfaceResourceName)
+
+	iface, err := client.Get(ctx, resGroup, nicName, "")
+	if err != nil {
+		if wasNotFound(iface.Response) {
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Network Interface %q (Resource Group %q): %+v", nicName, resGroup, err)
+	}
+
+	ipConfig, err := findNetworkInterfaceIPConfiguration(&iface, ipConfigName)
+	if err != nil {
+		return nil
+	}
+
+	ipConfig.ApplicationSecurityGroups = removeApplicationSecurityGroupID(ipConfig.ApplicationSecurityGroups, applicationSecurityGroupID)
+
+	future, err := client.CreateOrUpdate(ctx, resGroup, nicName, iface)
+	if err != nil {
+		return fmt.Errorf("Error updating Network Interface %q (Resource Group %q): %+v", nicName, resGroup, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for update of Network Interface %q (Resource Group %q): %+v", nicName, resGroup, err)
+	}
+
+	return nil
+}
+
+func addApplicationSecurityGroupID(existing *[]network.ApplicationSecurityGroup, id string) *[]network.ApplicationSecurityGroup {
+	if existing == nil {
+		return &[]network.ApplicationSecurityGroup{{ID: utils.String(id)}}
+	}
+
+	if hasApplicationSecurityGroupID(existing, id) {
+		return existing
+	}
+
+	updated := append(*existing, network.ApplicationSecurityGroup{ID: utils.String(id)})
+	return &updated
+}
+
+func removeApplicationSecurityGroupID(existing *[]network.ApplicationSecurityGroup, id string) *[]network.ApplicationSecurityGroup {
+	if existing == nil {
+		return existing
+	}
+
+	updated := make([]network.ApplicationSecurityGroup, 0, len(*existing))
+	for _, group := range *existing {
+		if group.ID == nil || *group.ID != id {
+			updated = append(updated, group)
+		}
+	}
+
+	return &updated
+}
+
+func hasApplicationSecurityGroupID(existing *[]network.ApplicationSecurityGroup, id string) bool {
+	if existing == nil {
+		return false
+	}
+
+	for _, group := range *existing {
+		if group.ID != nil && *group.ID == id {
+			return true
+		}
+	}
+
+	return false
+}
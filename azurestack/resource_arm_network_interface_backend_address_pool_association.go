@@ -0,0 +1,265 @@
+package azurestack
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/2019-03-01/network/mgmt/network"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+	"github.com/terraform-providers/terraform-provider-azurestack/azurestack/helpers/azure"
+)
+
+func resourceArmNetworkInterfaceBackendAddressPoolAssociation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmNetworkInterfaceBackendAddressPoolAssociationCreate,
+		Read:   resourceArmNetworkInterfaceBackendAddressPoolAssociationRead,
+		Delete: resourceArmNetworkInterfaceBackendAddressPoolAssociationDelete,
+
+		Schema: map[string]*schema.Schema{
+			"network_interface_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"ip_configuration_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"backend_address_pool_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+		},
+	}
+}
+
+// networkInterfaceBackendAddressPoolAssociationID is a synthetic, non-ARM ID - there's no ARM
+// sub-resource representing this association, so its identity is defined here as the tuple that
+// locates it inside the parent Network Interface's IP Configuration.
+func networkInterfaceBackendAddressPoolAssociationID(nicID, ipConfigName, backendPoolID string) string {
+	return strings.Join([]string{nicID, ipConfigName, backendPoolID}, "|")
+}
+
+func parseNetworkInterfaceBackendAddressPoolAssociationID(id string) (nicID, ipConfigName, backendPoolID string, err error) {
+	parts := strings.Split(id, "|")
+	if len(parts) != 3 {
+		return "", "", "", fmt.Errorf("Error parsing Network Interface Backend Address Pool Association ID %q: expected 3 `|`-separated segments", id)
+	}
+
+	return parts[0], parts[1], parts[2], nil
+}
+
+func resourceArmNetworkInterfaceBackendAddressPoolAssociationCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).ifaceClient
+	ctx := meta.(*ArmClient).StopContext
+
+	nicID := d.Get("network_interface_id").(string)
+	ipConfigName := d.Get("ip_configuration_name").(string)
+	backendPoolID := d.Get("backend_address_pool_id").(string)
+
+	id, err := parseAzureResourceID(nicID)
+	if err != nil {
+		return err
+	}
+	resGroup := id.ResourceGroup
+	nicName := id.Path["networkInterfaces"]
+
+	if err := azureStackLockByName(nicName, networkInterfaceResourceName); err != nil {
+		return err
+	}
+	defer azureStackUnlockByName(nicName, networkInterfaceResourceName)
+
+	// this association has no ARM sub-resource of its own - it's a reference held inside the parent
+	// Network Interface's IP Configuration - so, unlike the LoadBalancer sub-resources, there's no
+	// per-write endpoint to target: the whole Network Interface has to be read back, modified in
+	// place and written back
+	iface, err := client.Get(ctx, resGroup, nicName, "")
+	if err != nil {
+		return fmt.Errorf("Error retrieving Network Interface %q (Resource Group %q): %+v", nicName, resGroup, err)
+	}
+
+	ipConfig, err := findNetworkInterfaceIPConfiguration(&iface, ipConfigName)
+	if err != nil {
+		return err
+	}
+
+	ipConfig.LoadBalancerBackendAddressPools = addBackendAddressPoolID(ipConfig.LoadBalancerBackendAddressPools, backendPoolID)
+
+	future, err := client.CreateOrUpdate(ctx, resGroup, nicName, iface)
+	if err != nil {
+		return fmt.Errorf("Error updating Network Interface %q (Resource Group %q): %+v", nicName, resGroup, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for update of Network Interface %q (Resource Group %q): %+v", nicName, resGroup, err)
+	}
+
+	d.SetId(networkInterfaceBackendAddressPoolAssociationID(nicID, ipConfigName, backendPoolID))
+
+	return resourceArmNetworkInterfaceBackendAddressPoolAssociationRead(d, meta)
+}
+
+func resourceArmNetworkInterfaceBackendAddressPoolAssociationRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).ifaceClient
+	ctx := meta.(*ArmClient).StopContext
+
+	nicID, ipConfigName, backendPoolID, err := parseNetworkInterfaceBackendAddressPoolAssociationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	id, err := parseAzureResourceID(nicID)
+	if err != nil {
+		return err
+	}
+	resGroup := id.ResourceGroup
+	nicName := id.Path["networkInterfaces"]
+
+	iface, err := client.Get(ctx, resGroup, nicName, "")
+	if err != nil {
+		if wasNotFound(iface.Response) {
+			log.Printf("[INFO] Network Interface %q not found. Removing from state", nicName)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Network Interface %q (Resource Group %q): %+v", nicName, resGroup, err)
+	}
+
+	ipConfig, err := findNetworkInterfaceIPConfiguration(&iface, ipConfigName)
+	if err != nil {
+		log.Printf("[INFO] %s. Removing Network Interface Backend Address Pool Association from state", err)
+		d.SetId("")
+		return nil
+	}
+
+	if !hasBackendAddressPoolID(ipConfig.LoadBalancerBackendAddressPools, backendPoolID) {
+		log.Printf("[INFO] Backend Address Pool %q is no longer associated. Removing Network Interface Backend Address Pool Association from state", backendPoolID)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("network_interface_id", nicID)
+	d.Set("ip_configuration_name", ipConfigName)
+	d.Set("backend_address_pool_id", backendPoolID)
+
+	return nil
+}
+
+func resourceArmNetworkInterfaceBackendAddressPoolAssociationDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).ifaceClient
+	ctx := meta.(*ArmClient).StopContext
+
+	nicID, ipConfigName, backendPoolID, err := parseNetworkInterfaceBackendAddressPoolAssociationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	id, err := parseAzureResourceID(nicID)
+	if err != nil {
+		return err
+	}
+	resGroup := id.ResourceGroup
+	nicName := id.Path["networkInterfaces"]
+
+	if err := azureStackLockByName(nicName, networkInterfaceResourceName); err != nil {
+		return err
+	}
+	defer azureStackUnlockByName(nicName, networkInterfaceResourceName)
+
+	iface, err := client.Get(ctx, resGroup, nicName, "")
+	if err != nil {
+		if wasNotFound(iface.Response) {
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Network Interface %q (Resource Group %q): %+v", nicName, resGroup, err)
+	}
+
+	ipConfig, err := findNetworkInterfaceIPConfiguration(&iface, ipConfigName)
+	if err != nil {
+		return nil
+	}
+
+	ipConfig.LoadBalancerBackendAddressPools = removeBackendAddressPoolID(ipConfig.LoadBalancerBackendAddressPools, backendPoolID)
+
+	future, err := client.CreateOrUpdate(ctx, resGroup, nicName, iface)
+	if err != nil {
+		return fmt.Errorf("Error updating Network Interface %q (Resource Group %q): %+v", nicName, resGroup, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for update of Network Interface %q (Resource Group %q): %+v", nicName, resGroup, err)
+	}
+
+	return nil
+}
+
+// findNetworkInterfaceIPConfiguration returns a pointer into iface's own IP Configuration list, so
+// that the caller can mutate it in place before writing the whole Network Interface back.
+func findNetworkInterfaceIPConfiguration(iface *network.Interface, ipConfigName string) (*network.InterfaceIPConfigurationPropertiesFormat, error) {
+	if iface.InterfacePropertiesFormat == nil || iface.InterfacePropertiesFormat.IPConfigurations == nil {
+		return nil, fmt.Errorf("Network Interface %q has no IP Configurations", *iface.Name)
+	}
+
+	for _, ipConfig := range *iface.InterfacePropertiesFormat.IPConfigurations {
+		if ipConfig.Name != nil && *ipConfig.Name == ipConfigName {
+			// ipConfig is a copy of the slice element, but its embedded properties field is itself
+			// a pointer to the same underlying struct - mutating through it still mutates iface's
+			// own IP Configuration list in place
+			return ipConfig.InterfaceIPConfigurationPropertiesFormat, nil
+		}
+	}
+
+	return nil, fmt.Errorf("IP Configuration %q was not found on Network Interface %q", ipConfigName, *iface.Name)
+}
+
+func addBackendAddressPoolID(existing *[]network.BackendAddressPool, id string) *[]network.BackendAddressPool {
+	if existing == nil {
+		return &[]network.BackendAddressPool{{ID: utils.String(id)}}
+	}
+
+	if hasBackendAddressPoolID(existing, id) {
+		return existing
+	}
+
+	updated := append(*existing, network.BackendAddressPool{ID: utils.String(id)})
+	return &updated
+}
+
+func removeBackendAddressPoolID(existing *[]network.BackendAddressPool, id string) *[]network.BackendAddressPool {
+	if existing == nil {
+		return existing
+	}
+
+	updated := make([]network.BackendAddressPool, 0, len(*existing))
+	for _, pool := range *existing {
+		if pool.ID == nil || *pool.ID != id {
+			updated = append(updated, pool)
+		}
+	}
+
+	return &updated
+}
+
+func hasBackendAddressPoolID(existing *[]network.BackendAddressPool, id string) bool {
+	if existing == nil {
+		return false
+	}
+
+	for _, pool := range *existing {
+		if pool.ID != nil && *pool.ID == id {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,94 @@
+package azurestack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccAzureStackNetworkInterfaceBackendAddressPoolAssociation_basic(t *testing.T) {
+	resourceName := "azurestack_network_interface_backend_address_pool_association.test"
+	ri := acctest.RandInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureStackNetworkInterfaceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureStackNetworkInterfaceBackendAddressPoolAssociation_basic(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "network_interface_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "backend_address_pool_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAzureStackNetworkInterfaceBackendAddressPoolAssociation_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurestack_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurestack_virtual_network" "test" {
+  name                = "acctestvn-%[1]d"
+  resource_group_name = "${azurestack_resource_group.test.name}"
+  location            = "${azurestack_resource_group.test.location}"
+  address_space       = ["10.0.0.0/8"]
+}
+
+resource "azurestack_subnet" "test" {
+  name                 = "acctestsn-%[1]d"
+  resource_group_name  = "${azurestack_resource_group.test.name}"
+  virtual_network_name = "${azurestack_virtual_network.test.name}"
+  address_prefix       = "10.0.1.0/24"
+}
+
+resource "azurestack_public_ip" "test" {
+  name                         = "acctestpip-%[1]d"
+  resource_group_name          = "${azurestack_resource_group.test.name}"
+  location                     = "${azurestack_resource_group.test.location}"
+  public_ip_address_allocation = "static"
+}
+
+resource "azurestack_lb" "test" {
+  name                = "acctestlb-%[1]d"
+  resource_group_name = "${azurestack_resource_group.test.name}"
+  location            = "${azurestack_resource_group.test.location}"
+
+  frontend_ip_configuration {
+    name                 = "ip-address"
+    public_ip_address_id = "${azurestack_public_ip.test.id}"
+  }
+}
+
+resource "azurestack_lb_backend_address_pool" "test" {
+  name                = "acctestbap-%[1]d"
+  resource_group_name = "${azurestack_resource_group.test.name}"
+  loadbalancer_id     = "${azurestack_lb.test.id}"
+}
+
+resource "azurestack_network_interface" "test" {
+  name                = "acctestnic-%[1]d"
+  location            = "${azurestack_resource_group.test.location}"
+  resource_group_name = "${azurestack_resource_group.test.name}"
+
+  ip_configuration {
+    name                          = "testconfiguration1"
+    subnet_id                     = "${azurestack_subnet.test.id}"
+    private_ip_address_allocation = "dynamic"
+  }
+}
+
+resource "azurestack_network_interface_backend_address_pool_association" "test" {
+  network_interface_id    = "${azurestack_network_interface.test.id}"
+  ip_configuration_name   = "testconfiguration1"
+  backend_address_pool_id = "${azurestack_lb_backend_address_pool.test.id}"
+}
+`, rInt, location)
+}
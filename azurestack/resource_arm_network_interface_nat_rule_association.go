@@ -0,0 +1,246 @@
+package azurestack
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/2019-03-01/network/mgmt/network"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+	"github.com/terraform-providers/terraform-provider-azurestack/azurestack/helpers/azure"
+)
+
+func resourceArmNetworkInterfaceNatRuleAssociation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmNetworkInterfaceNatRuleAssociationCreate,
+		Read:   resourceArmNetworkInterfaceNatRuleAssociationRead,
+		Delete: resourceArmNetworkInterfaceNatRuleAssociationDelete,
+
+		Schema: map[string]*schema.Schema{
+			"network_interface_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"ip_configuration_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"nat_rule_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+		},
+	}
+}
+
+// networkInterfaceNatRuleAssociationID is a synthetic, non-ARM ID - there's no ARM sub-resource
+// representing this association, so its identity is defined here as the tuple that locates it
+// inside the parent Network Interface's IP Configuration.
+func networkInterfaceNatRuleAssociationID(nicID, ipConfigName, natRuleID string) string {
+	return strings.Join([]string{nicID, ipConfigName, natRuleID}, "|")
+}
+
+func parseNetworkInterfaceNatRuleAssociationID(id string) (nicID, ipConfigName, natRuleID string, err error) {
+	parts := strings.Split(id, "|")
+	if len(parts) != 3 {
+		return "", "", "", fmt.Errorf("Error parsing Network Interface NAT Rule Association ID %q: expected 3 `|`-separated segments", id)
+	}
+
+	return parts[0], parts[1], parts[2], nil
+}
+
+func resourceArmNetworkInterfaceNatRuleAssociationCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).ifaceClient
+	ctx := meta.(*ArmClient).StopContext
+
+	nicID := d.Get("network_interface_id").(string)
+	ipConfigName := d.Get("ip_configuration_name").(string)
+	natRuleID := d.Get("nat_rule_id").(string)
+
+	id, err := parseAzureResourceID(nicID)
+	if err != nil {
+		return err
+	}
+	resGroup := id.ResourceGroup
+	nicName := id.Path["networkInterfaces"]
+
+	if err := azureStackLockByName(nicName, networkInterfaceResourceName); err != nil {
+		return err
+	}
+	defer azureStackUnlockByName(nicName, networkInterfaceResourceName)
+
+	// this association has no ARM sub-resource of its own - it's a reference held inside the parent
+	// Network Interface's IP Configuration - so, unlike the LoadBalancer sub-resources, there's no
+	// per-write endpoint to target: the whole Network Interface has to be read back, modified in
+	// place and written back
+	iface, err := client.Get(ctx, resGroup, nicName, "")
+	if err != nil {
+		return fmt.Errorf("Error retrieving Network Interface %q (Resource Group %q): %+v", nicName, resGroup, err)
+	}
+
+	ipConfig, err := findNetworkInterfaceIPConfiguration(&iface, ipConfigName)
+	if err != nil {
+		return err
+	}
+
+	ipConfig.LoadBalancerInboundNatRules = addInboundNatRuleID(ipConfig.LoadBalancerInboundNatRules, natRuleID)
+
+	future, err := client.CreateOrUpdate(ctx, resGroup, nicName, iface)
+	if err != nil {
+		return fmt.Errorf("Error updating Network Interface %q (Resource Group %q): %+v", nicName, resGroup, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for update of Network Interface %q (Resource Group %q): %+v", nicName, resGroup, err)
+	}
+
+	d.SetId(networkInterfaceNatRuleAssociationID(nicID, ipConfigName, natRuleID))
+
+	return resourceArmNetworkInterfaceNatRuleAssociationRead(d, meta)
+}
+
+func resourceArmNetworkInterfaceNatRuleAssociationRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).ifaceClient
+	ctx := meta.(*ArmClient).StopContext
+
+	nicID, ipConfigName, natRuleID, err := parseNetworkInterfaceNatRuleAssociationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	id, err := parseAzureResourceID(nicID)
+	if err != nil {
+		return err
+	}
+	resGroup := id.ResourceGroup
+	nicName := id.Path["networkInterfaces"]
+
+	iface, err := client.Get(ctx, resGroup, nicName, "")
+	if err != nil {
+		if wasNotFound(iface.Response) {
+			log.Printf("[INFO] Network Interface %q not found. Removing from state", nicName)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Network Interface %q (Resource Group %q): %+v", nicName, resGroup, err)
+	}
+
+	ipConfig, err := findNetworkInterfaceIPConfiguration(&iface, ipConfigName)
+	if err != nil {
+		log.Printf("[INFO] %s. Removing Network Interface NAT Rule Association from state", err)
+		d.SetId("")
+		return nil
+	}
+
+	if !hasInboundNatRuleID(ipConfig.LoadBalancerInboundNatRules, natRuleID) {
+		log.Printf("[INFO] NAT Rule %q is no longer associated. Removing Network Interface NAT Rule Association from state", natRuleID)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("network_interface_id", nicID)
+	d.Set("ip_configuration_name", ipConfigName)
+	d.Set("nat_rule_id", natRuleID)
+
+	return nil
+}
+
+func resourceArmNetworkInterfaceNatRuleAssociationDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).ifaceClient
+	ctx := meta.(*ArmClient).StopContext
+
+	nicID, ipConfigName, natRuleID, err := parseNetworkInterfaceNatRuleAssociationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	id, err := parseAzureResourceID(nicID)
+	if err != nil {
+		return err
+	}
+	resGroup := id.ResourceGroup
+	nicName := id.Path["networkInterfaces"]
+
+	if err := azureStackLockByName(nicName, networkInterfaceResourceName); err != nil {
+		return err
+	}
+	defer azureStackUnlockByName(nicName, networkInterfaceResourceName)
+
+	iface, err := client.Get(ctx, resGroup, nicName, "")
+	if err != nil {
+		if wasNotFound(iface.Response) {
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Network Interface %q (Resource Group %q): %+v", nicName, resGroup, err)
+	}
+
+	ipConfig, err := findNetworkInterfaceIPConfiguration(&iface, ipConfigName)
+	if err != nil {
+		return nil
+	}
+
+	ipConfig.LoadBalancerInboundNatRules = removeInboundNatRuleID(ipConfig.LoadBalancerInboundNatRules, natRuleID)
+
+	future, err := client.CreateOrUpdate(ctx, resGroup, nicName, iface)
+	if err != nil {
+		return fmt.Errorf("Error updating Network Interface %q (Resource Group %q): %+v", nicName, resGroup, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for update of Network Interface %q (Resource Group %q): %+v", nicName, resGroup, err)
+	}
+
+	return nil
+}
+
+func addInboundNatRuleID(existing *[]network.InboundNatRule, id string) *[]network.InboundNatRule {
+	if existing == nil {
+		return &[]network.InboundNatRule{{ID: utils.String(id)}}
+	}
+
+	if hasInboundNatRuleID(existing, id) {
+		return existing
+	}
+
+	updated := append(*existing, network.InboundNatRule{ID: utils.String(id)})
+	return &updated
+}
+
+func removeInboundNatRuleID(existing *[]network.InboundNatRule, id string) *[]network.InboundNatRule {
+	if existing == nil {
+		return existing
+	}
+
+	updated := make([]network.InboundNatRule, 0, len(*existing))
+	for _, rule := range *existing {
+		if rule.ID == nil || *rule.ID != id {
+			updated = append(updated, rule)
+		}
+	}
+
+	return &updated
+}
+
+func hasInboundNatRuleID(existing *[]network.InboundNatRule, id string) bool {
+	if existing == nil {
+		return false
+	}
+
+	for _, rule := range *existing {
+		if rule.ID != nil && *rule.ID == id {
+			return true
+		}
+	}
+
+	return false
+}
@@ -1,6 +1,7 @@
 package azurestack
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2017-10-01/network"
@@ -19,6 +20,13 @@ func resourceArmNetworkSecurityRule() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: customizeDiffAll(
+			customizeDiffMutuallyExclusive("source_port_range", "source_port_ranges"),
+			customizeDiffMutuallyExclusive("destination_port_range", "destination_port_ranges"),
+			customizeDiffMutuallyExclusive("source_address_prefix", "source_address_prefixes"),
+			customizeDiffMutuallyExclusive("destination_address_prefix", "destination_address_prefixes"),
+		),
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -55,74 +63,50 @@ func resourceArmNetworkSecurityRule() *schema.Resource {
 			"source_port_range": {
 				Type:     schema.TypeString,
 				Optional: true,
-
-				// Since this is not supported it will not conflict
-				// ConflictsWith: []string{"source_port_ranges"},
 			},
 
-			// The following fields are not supported by the profile 2017-03-09
-			// source_port_ranges
-			// destination_port_ranges
-			// source_address_prefixes
-			// destination_address_prefixes
-			// source_application_security_group_ids
-			// destination_application_security_group_ids
-
-			// "source_port_ranges": {
-			// 	Type:          schema.TypeSet,
-			// 	Optional:      true,
-			// 	Elem:          &schema.Schema{Type: schema.TypeString},
-			// 	Set:           schema.HashString,
-			// 	ConflictsWith: []string{"source_port_range"},
-			// },
+			"source_port_ranges": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
 
 			"destination_port_range": {
 				Type:     schema.TypeString,
 				Optional: true,
-
-				// Since this is not supported it will not conflict
-				// ConflictsWith: []string{"destination_port_ranges"},
 			},
 
-			// "destination_port_ranges": {
-			// 	Type:          schema.TypeSet,
-			// 	Optional:      true,
-			// 	Elem:          &schema.Schema{Type: schema.TypeString},
-			// 	Set:           schema.HashString,
-			// 	ConflictsWith: []string{"destination_port_range"},
-			// },
+			"destination_port_ranges": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
 
 			"source_address_prefix": {
 				Type:     schema.TypeString,
 				Optional: true,
-
-				// Since this is not supported it will not conflict
-				// ConflictsWith: []string{"source_address_prefixes"},
 			},
 
-			// "source_address_prefixes": {
-			// 	Type:          schema.TypeSet,
-			// 	Optional:      true,
-			// 	Elem:          &schema.Schema{Type: schema.TypeString},
-			// 	Set:           schema.HashString,
-			// 	ConflictsWith: []string{"source_address_prefix"},
-			// },
+			"source_address_prefixes": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
 
 			"destination_address_prefix": {
 				Type:     schema.TypeString,
 				Optional: true,
-
-				// Since this is not supported it will not conflict
-				// ConflictsWith: []string{"destination_address_prefixes"},
 			},
 
-			// "destination_address_prefixes": {
-			// 	Type:          schema.TypeSet,
-			// 	Optional:      true,
-			// 	Elem:          &schema.Schema{Type: schema.TypeString},
-			// 	Set:           schema.HashString,
-			// 	ConflictsWith: []string{"destination_address_prefix"},
-			// },
+			"destination_address_prefixes": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
 
 			"source_application_security_group_ids": {
 				Type:     schema.TypeSet,
@@ -131,12 +115,12 @@ func resourceArmNetworkSecurityRule() *schema.Resource {
 				Set:      schema.HashString,
 			},
 
-			// "destination_application_security_group_ids": {
-			// 	Type:     schema.TypeSet,
-			// 	Optional: true,
-			// 	Elem:     &schema.Schema{Type: schema.TypeString},
-			// 	Set:      schema.HashString,
-			// },
+			"destination_application_security_group_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
 
 			// Constants not in 2017-03-09 profile
 			"access": {
@@ -149,9 +133,26 @@ func resourceArmNetworkSecurityRule() *schema.Resource {
 				DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
 			},
 
+			// Optional + Computed: when left unset, a free priority within
+			// `priority_range_start`/`priority_range_end` is assigned on create and then held fixed.
 			"priority": {
 				Type:         schema.TypeInt,
-				Required:     true,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(100, 4096),
+			},
+
+			"priority_range_start": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      100,
+				ValidateFunc: validation.IntBetween(100, 4096),
+			},
+
+			"priority_range_end": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      4096,
 				ValidateFunc: validation.IntBetween(100, 4096),
 			},
 
@@ -177,29 +178,35 @@ func resourceArmNetworkSecurityRuleCreate(d *schema.ResourceData, meta interface
 	nsgName := d.Get("network_security_group_name").(string)
 	resGroup := d.Get("resource_group_name").(string)
 
-	sourcePortRange := d.Get("source_port_range").(string)
-	destinationPortRange := d.Get("destination_port_range").(string)
-	sourceAddressPrefix := d.Get("source_address_prefix").(string)
-	destinationAddressPrefix := d.Get("destination_address_prefix").(string)
 	priority := int32(d.Get("priority").(int))
 	access := d.Get("access").(string)
 	direction := d.Get("direction").(string)
 	protocol := d.Get("protocol").(string)
 
-	azureStackLockByName(nsgName, networkSecurityGroupResourceName)
+	if err := azureStackLockByName(nsgName, networkSecurityGroupResourceName); err != nil {
+		return err
+	}
 	defer azureStackUnlockByName(nsgName, networkSecurityGroupResourceName)
 
+	if priority == 0 {
+		rangeStart := int32(d.Get("priority_range_start").(int))
+		rangeEnd := int32(d.Get("priority_range_end").(int))
+
+		assigned, err := nextAvailableNetworkSecurityRulePriority(ctx, meta.(*ArmClient).secGroupClient, resGroup, nsgName, direction, rangeStart, rangeEnd)
+		if err != nil {
+			return err
+		}
+
+		priority = assigned
+	}
+
 	rule := network.SecurityRule{
 		Name: &name,
 		SecurityRulePropertiesFormat: &network.SecurityRulePropertiesFormat{
-			SourcePortRange:          &sourcePortRange,
-			DestinationPortRange:     &destinationPortRange,
-			SourceAddressPrefix:      &sourceAddressPrefix,
-			DestinationAddressPrefix: &destinationAddressPrefix,
-			Priority:                 &priority,
-			Access:                   network.SecurityRuleAccess(access),
-			Direction:                network.SecurityRuleDirection(direction),
-			Protocol:                 network.SecurityRuleProtocol(protocol),
+			Priority:  &priority,
+			Access:    network.SecurityRuleAccess(access),
+			Direction: network.SecurityRuleDirection(direction),
+			Protocol:  network.SecurityRuleProtocol(protocol),
 		},
 	}
 
@@ -208,75 +215,71 @@ func resourceArmNetworkSecurityRuleCreate(d *schema.ResourceData, meta interface
 		rule.SecurityRulePropertiesFormat.Description = &description
 	}
 
-	// The following fields are not supported by the profile 2017-03-09
-	// source_port_ranges
-	// destination_port_ranges
-	// source_address_prefixes
-	// destination_address_prefixes
-	// source_application_security_group_ids
-	// destination_application_security_group_ids
-
-	// if r, ok := d.GetOk("source_port_ranges"); ok {
-	// 	var sourcePortRanges []string
-	// 	r := r.(*schema.Set).List()
-	// 	for _, v := range r {
-	// 		s := v.(string)
-	// 		sourcePortRanges = append(sourcePortRanges, s)
-	// 	}
-	// 	rule.SecurityRulePropertiesFormat.SourcePortRanges = &sourcePortRanges
-	// }
-
-	// if r, ok := d.GetOk("destination_port_ranges"); ok {
-	// 	var destinationPortRanges []string
-	// 	r := r.(*schema.Set).List()
-	// 	for _, v := range r {
-	// 		s := v.(string)
-	// 		destinationPortRanges = append(destinationPortRanges, s)
-	// 	}
-	// 	rule.SecurityRulePropertiesFormat.DestinationPortRanges = &destinationPortRanges
-	// }
-
-	// if r, ok := d.GetOk("source_address_prefixes"); ok {
-	// 	var sourceAddressPrefixes []string
-	// 	r := r.(*schema.Set).List()
-	// 	for _, v := range r {
-	// 		s := v.(string)
-	// 		sourceAddressPrefixes = append(sourceAddressPrefixes, s)
-	// 	}
-	// 	rule.SecurityRulePropertiesFormat.SourceAddressPrefixes = &sourceAddressPrefixes
-	// }
-
-	// if r, ok := d.GetOk("destination_address_prefixes"); ok {
-	// 	var destinationAddressPrefixes []string
-	// 	r := r.(*schema.Set).List()
-	// 	for _, v := range r {
-	// 		s := v.(string)
-	// 		destinationAddressPrefixes = append(destinationAddressPrefixes, s)
-	// 	}
-	// 	rule.SecurityRulePropertiesFormat.DestinationAddressPrefixes = &destinationAddressPrefixes
-	// }
-
-	// if r, ok := d.GetOk("source_application_security_group_ids"); ok {
-	// 	var sourceApplicationSecurityGroups []network.ApplicationSecurityGroup
-	// 	for _, v := range r.(*schema.Set).List() {
-	// 		sg := network.ApplicationSecurityGroup{
-	// 			ID: utils.String(v.(string)),
-	// 		}
-	// 		sourceApplicationSecurityGroups = append(sourceApplicationSecurityGroups, sg)
-	// 	}
-	// 	rule.SourceApplicationSecurityGroups = &sourceApplicationSecurityGroups
-	// }
-
-	// if r, ok := d.GetOk("destination_application_security_group_ids"); ok {
-	// 	var destinationApplicationSecurityGroups []network.ApplicationSecurityGroup
-	// 	for _, v := range r.(*schema.Set).List() {
-	// 		sg := network.ApplicationSecurityGroup{
-	// 			ID: utils.String(v.(string)),
-	// 		}
-	// 		destinationApplicationSecurityGroups = append(destinationApplicationSecurityGroups, sg)
-	// 	}
-	// 	rule.DestinationApplicationSecurityGroups = &destinationApplicationSecurityGroups
-	// }
+	if r, ok := d.GetOk("source_port_ranges"); ok {
+		var sourcePortRanges []string
+		for _, v := range r.(*schema.Set).List() {
+			sourcePortRanges = append(sourcePortRanges, v.(string))
+		}
+		rule.SecurityRulePropertiesFormat.SourcePortRanges = &sourcePortRanges
+	} else {
+		sourcePortRange := d.Get("source_port_range").(string)
+		rule.SecurityRulePropertiesFormat.SourcePortRange = &sourcePortRange
+	}
+
+	if r, ok := d.GetOk("destination_port_ranges"); ok {
+		var destinationPortRanges []string
+		for _, v := range r.(*schema.Set).List() {
+			destinationPortRanges = append(destinationPortRanges, v.(string))
+		}
+		rule.SecurityRulePropertiesFormat.DestinationPortRanges = &destinationPortRanges
+	} else {
+		destinationPortRange := d.Get("destination_port_range").(string)
+		rule.SecurityRulePropertiesFormat.DestinationPortRange = &destinationPortRange
+	}
+
+	if r, ok := d.GetOk("source_address_prefixes"); ok {
+		var sourceAddressPrefixes []string
+		for _, v := range r.(*schema.Set).List() {
+			sourceAddressPrefixes = append(sourceAddressPrefixes, v.(string))
+		}
+		rule.SecurityRulePropertiesFormat.SourceAddressPrefixes = &sourceAddressPrefixes
+	} else {
+		sourceAddressPrefix := d.Get("source_address_prefix").(string)
+		rule.SecurityRulePropertiesFormat.SourceAddressPrefix = &sourceAddressPrefix
+	}
+
+	if r, ok := d.GetOk("destination_address_prefixes"); ok {
+		var destinationAddressPrefixes []string
+		for _, v := range r.(*schema.Set).List() {
+			destinationAddressPrefixes = append(destinationAddressPrefixes, v.(string))
+		}
+		rule.SecurityRulePropertiesFormat.DestinationAddressPrefixes = &destinationAddressPrefixes
+	} else {
+		destinationAddressPrefix := d.Get("destination_address_prefix").(string)
+		rule.SecurityRulePropertiesFormat.DestinationAddressPrefix = &destinationAddressPrefix
+	}
+
+	if r, ok := d.GetOk("source_application_security_group_ids"); ok {
+		var sourceApplicationSecurityGroups []network.ApplicationSecurityGroup
+		for _, v := range r.(*schema.Set).List() {
+			sg := network.ApplicationSecurityGroup{
+				ID: utils.String(v.(string)),
+			}
+			sourceApplicationSecurityGroups = append(sourceApplicationSecurityGroups, sg)
+		}
+		rule.SecurityRulePropertiesFormat.SourceApplicationSecurityGroups = &sourceApplicationSecurityGroups
+	}
+
+	if r, ok := d.GetOk("destination_application_security_group_ids"); ok {
+		var destinationApplicationSecurityGroups []network.ApplicationSecurityGroup
+		for _, v := range r.(*schema.Set).List() {
+			sg := network.ApplicationSecurityGroup{
+				ID: utils.String(v.(string)),
+			}
+			destinationApplicationSecurityGroups = append(destinationApplicationSecurityGroups, sg)
+		}
+		rule.SecurityRulePropertiesFormat.DestinationApplicationSecurityGroups = &destinationApplicationSecurityGroups
+	}
 
 	future, err := client.CreateOrUpdate(ctx, resGroup, nsgName, name, rule)
 	if err != nil {
@@ -315,7 +318,7 @@ func resourceArmNetworkSecurityRuleRead(d *schema.ResourceData, meta interface{}
 
 	resp, err := client.Get(ctx, resGroup, networkSGName, sgRuleName)
 	if err != nil {
-		if utils.ResponseWasNotFound(resp.Response) {
+		if wasNotFound(resp.Response) {
 			d.SetId("")
 			return nil
 		}
@@ -330,18 +333,18 @@ func resourceArmNetworkSecurityRuleRead(d *schema.ResourceData, meta interface{}
 		d.Set("description", props.Description)
 		d.Set("protocol", string(props.Protocol))
 		d.Set("destination_address_prefix", props.DestinationAddressPrefix)
+		d.Set("destination_address_prefixes", props.DestinationAddressPrefixes)
 		d.Set("destination_port_range", props.DestinationPortRange)
+		d.Set("destination_port_ranges", props.DestinationPortRanges)
 		d.Set("source_address_prefix", props.SourceAddressPrefix)
+		d.Set("source_address_prefixes", props.SourceAddressPrefixes)
 		d.Set("source_port_range", props.SourcePortRange)
+		d.Set("source_port_ranges", props.SourcePortRanges)
 		d.Set("access", string(props.Access))
-		d.Set("priority", int(*props.Priority))
+		d.Set("priority", int(int32Value(props.Priority)))
 		d.Set("direction", string(props.Direction))
-
-		// The following fields are not supported by the profile 2017-03-09
-		// d.Set("destination_port_ranges", props.DestinationPortRanges)
-		// d.Set("destination_address_prefixes", props.DestinationAddressPrefixes)
-		// d.Set("source_address_prefixes", props.SourceAddressPrefixes)
-		// d.Set("source_port_ranges", props.SourcePortRanges)
+		d.Set("source_application_security_group_ids", flattenNetworkSecurityRuleApplicationSecurityGroupIds(props.SourceApplicationSecurityGroups))
+		d.Set("destination_application_security_group_ids", flattenNetworkSecurityRuleApplicationSecurityGroupIds(props.DestinationApplicationSecurityGroups))
 	}
 
 	return nil
@@ -359,7 +362,9 @@ func resourceArmNetworkSecurityRuleDelete(d *schema.ResourceData, meta interface
 	nsgName := id.Path["networkSecurityGroups"]
 	sgRuleName := id.Path["securityRules"]
 
-	azureStackLockByName(nsgName, networkSecurityGroupResourceName)
+	if err := azureStackLockByName(nsgName, networkSecurityGroupResourceName); err != nil {
+		return err
+	}
 	defer azureStackUnlockByName(nsgName, networkSecurityGroupResourceName)
 
 	future, err := client.Delete(ctx, resGroup, nsgName, sgRuleName)
@@ -374,3 +379,50 @@ func resourceArmNetworkSecurityRuleDelete(d *schema.ResourceData, meta interface
 
 	return err
 }
+
+// nextAvailableNetworkSecurityRulePriority scans the existing rules on a Network Security Group for
+// the given direction and returns the lowest unused priority within [rangeStart, rangeEnd]. The
+// caller is expected to already hold the per-NSG lock, so that two rules created concurrently on the
+// same NSG can't both be assigned the same priority.
+func nextAvailableNetworkSecurityRulePriority(ctx context.Context, client network.SecurityGroupsClient, resGroup, nsgName, direction string, rangeStart, rangeEnd int32) (int32, error) {
+	nsg, err := client.Get(ctx, resGroup, nsgName, "")
+	if err != nil {
+		return 0, fmt.Errorf("Error retrieving Network Security Group %q (Resource Group %q): %+v", nsgName, resGroup, err)
+	}
+
+	used := make(map[int32]bool)
+	if props := nsg.SecurityGroupPropertiesFormat; props != nil && props.SecurityRules != nil {
+		for _, rule := range *props.SecurityRules {
+			if rule.SecurityRulePropertiesFormat == nil || rule.SecurityRulePropertiesFormat.Priority == nil {
+				continue
+			}
+			if rule.SecurityRulePropertiesFormat.Direction != network.SecurityRuleDirection(direction) {
+				continue
+			}
+			used[*rule.SecurityRulePropertiesFormat.Priority] = true
+		}
+	}
+
+	for p := rangeStart; p <= rangeEnd; p++ {
+		if !used[p] {
+			return p, nil
+		}
+	}
+
+	return 0, fmt.Errorf("Error: no free priority available for Network Security Group %q (Resource Group %q) in the range %d-%d for %s rules", nsgName, resGroup, rangeStart, rangeEnd, direction)
+}
+
+func flattenNetworkSecurityRuleApplicationSecurityGroupIds(groups *[]network.ApplicationSecurityGroup) []string {
+	ids := make([]string, 0)
+	if groups == nil {
+		return ids
+	}
+
+	for _, group := range *groups {
+		if group.ID != nil {
+			ids = append(ids, *group.ID)
+		}
+	}
+
+	return ids
+}
@@ -3,6 +3,7 @@ package azurestack
 import (
 	"fmt"
 	"net/http"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
@@ -35,6 +36,25 @@ func TestAccAzureStackNetworkSecurityRule_basic(t *testing.T) {
 	})
 }
 
+func TestAccAzureStackNetworkSecurityRule_autoPriority(t *testing.T) {
+	resourceName := "azurestack_network_security_rule.test"
+	rInt := acctest.RandInt()
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureStackNetworkSecurityRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureStackNetworkSecurityRule_autoPriority(rInt, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureStackNetworkSecurityRuleExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "priority", "101"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAzureStackNetworkSecurityRule_disappears(t *testing.T) {
 	resourceGroup := "azurestack_network_security_rule.test"
 	rInt := acctest.RandInt()
@@ -98,11 +118,39 @@ func TestAccAzureStackNetworkSecurityRule_augmented(t *testing.T) {
 	})
 }
 
-// azurestack_application_security_group not in scope, skipping
-func TestAccAzureStackNetworkSecurityRule_applicationSecurityGroups(t *testing.T) {
+func TestAccAzureStackNetworkSecurityRule_augmentedRanges(t *testing.T) {
+	rInt := acctest.RandInt()
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureStackNetworkSecurityRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureStackNetworkSecurityRule_augmentedRanges(rInt, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureStackNetworkSecurityRuleExists("azurestack_network_security_rule.test1"),
+				),
+			},
+		},
+	})
+}
 
-	t.Skip()
+func TestAccAzureStackNetworkSecurityRule_augmentedRangesConflict(t *testing.T) {
+	rInt := acctest.RandInt()
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureStackNetworkSecurityRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAzureStackNetworkSecurityRule_augmentedRangesConflict(rInt, testLocation()),
+				ExpectError: regexp.MustCompile("only one of"),
+			},
+		},
+	})
+}
 
+func TestAccAzureStackNetworkSecurityRule_applicationSecurityGroups(t *testing.T) {
 	rInt := acctest.RandInt()
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -340,6 +388,65 @@ resource "azurestack_network_security_rule" "test1" {
 `, rInt, location)
 }
 
+func testAccAzureStackNetworkSecurityRule_augmentedRanges(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurestack_resource_group" "test1" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurestack_network_security_group" "test1" {
+  name                = "acceptanceTestSecurityGroup2"
+  location            = "${azurestack_resource_group.test1.location}"
+  resource_group_name = "${azurestack_resource_group.test1.name}"
+}
+
+resource "azurestack_network_security_rule" "test1" {
+  name                         = "test123"
+  priority                     = 100
+  direction                    = "Outbound"
+  access                       = "Allow"
+  protocol                     = "Tcp"
+  source_port_ranges           = ["10000-40000"]
+  destination_port_ranges      = ["80", "443"]
+  source_address_prefixes      = ["10.0.0.0/8", "192.168.0.0/16"]
+  destination_address_prefixes = ["172.16.0.0/20"]
+  resource_group_name          = "${azurestack_resource_group.test1.name}"
+  network_security_group_name  = "${azurestack_network_security_group.test1.name}"
+}
+`, rInt, location)
+}
+
+func testAccAzureStackNetworkSecurityRule_augmentedRangesConflict(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurestack_resource_group" "test1" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurestack_network_security_group" "test1" {
+  name                = "acceptanceTestSecurityGroup2"
+  location            = "${azurestack_resource_group.test1.location}"
+  resource_group_name = "${azurestack_resource_group.test1.name}"
+}
+
+resource "azurestack_network_security_rule" "test1" {
+  name                         = "test123"
+  priority                     = 100
+  direction                    = "Outbound"
+  access                       = "Allow"
+  protocol                     = "Tcp"
+  source_port_range            = "*"
+  source_port_ranges           = ["10000-40000"]
+  destination_port_range       = "*"
+  source_address_prefix        = "10.0.0.0/8"
+  destination_address_prefix   = "172.16.0.0/20"
+  resource_group_name          = "${azurestack_resource_group.test1.name}"
+  network_security_group_name  = "${azurestack_network_security_group.test1.name}"
+}
+`, rInt, location)
+}
+
 func testAccAzureStackNetworkSecurityRule_applicationSecurityGroups(rInt int, location string) string {
 	return fmt.Sprintf(`
 resource "azurestack_resource_group" "test" {
@@ -380,3 +487,49 @@ resource "azurestack_network_security_rule" "test1" {
 }
 `, rInt, location, rInt, rInt, rInt)
 }
+
+func testAccAzureStackNetworkSecurityRule_autoPriority(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurestack_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurestack_network_security_group" "test" {
+  name                = "acceptanceTestSecurityGroup1"
+  location            = "${azurestack_resource_group.test.location}"
+  resource_group_name = "${azurestack_resource_group.test.name}"
+}
+
+resource "azurestack_network_security_rule" "existing" {
+  name                        = "existing"
+  priority                    = 100
+  direction                   = "Outbound"
+  access                      = "Allow"
+  protocol                    = "Tcp"
+  source_port_range           = "*"
+  destination_port_range      = "*"
+  source_address_prefix       = "*"
+  destination_address_prefix  = "*"
+  resource_group_name         = "${azurestack_resource_group.test.name}"
+  network_security_group_name = "${azurestack_network_security_group.test.name}"
+}
+
+resource "azurestack_network_security_rule" "test" {
+  name                        = "test123"
+  priority_range_start        = 100
+  priority_range_end          = 200
+  direction                   = "Outbound"
+  access                      = "Allow"
+  protocol                    = "Tcp"
+  source_port_range           = "*"
+  destination_port_range      = "*"
+  source_address_prefix       = "*"
+  destination_address_prefix  = "*"
+  resource_group_name         = "${azurestack_resource_group.test.name}"
+  network_security_group_name = "${azurestack_network_security_group.test.name}"
+
+  depends_on = ["azurestack_network_security_rule.existing"]
+}
+`, rInt, location)
+}
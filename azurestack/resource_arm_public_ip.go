@@ -34,6 +34,11 @@ func resourceArmPublicIp() *schema.Resource {
 			},
 		},
 
+		CustomizeDiff: customizeDiffAll(
+			resourceArmPublicIpCustomizeDiff,
+			customizeDiffTagsWithDefaultTags,
+		),
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -141,7 +146,7 @@ func resourceArmPublicIpCreate(d *schema.ResourceData, meta interface{}) error {
 			PublicIPAllocationMethod: network.IPAllocationMethod(ipAllocationMethod),
 			IdleTimeoutInMinutes:     utils.Int32(int32(idleTimeout)),
 		},
-		Tags: *expandTags(tags),
+		Tags: *expandTags(meta, tags),
 		// Not supported for 2017-03-09 profile
 		// Sku:      &sku,
 		// Zones: zones,
@@ -201,7 +206,7 @@ func resourceArmPublicIpRead(d *schema.ResourceData, meta interface{}) error {
 
 	resp, err := client.Get(ctx, resGroup, name, "")
 	if err != nil {
-		if utils.ResponseWasNotFound(resp.Response) {
+		if wasNotFound(resp.Response) {
 			d.SetId("")
 			return nil
 		}
@@ -261,6 +266,21 @@ func resourceArmPublicIpDelete(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
+// resourceArmPublicIpCustomizeDiff marks ip_address as known-after-apply whenever the allocation is
+// (or is becoming) Dynamic - a Dynamic Public IP has no address until it's attached to a resource,
+// and can be assigned a different one on every attach/detach cycle, so Terraform can't assume the
+// value already in state still holds. A Static allocation's address is stable once assigned, so it's
+// left alone here.
+func resourceArmPublicIpCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	allocation := d.Get("public_ip_address_allocation").(string)
+
+	if strings.EqualFold(allocation, string(network.Dynamic)) {
+		return d.SetNewComputed("ip_address")
+	}
+
+	return nil
+}
+
 func validatePublicIpDomainNameLabel(v interface{}, k string) (ws []string, errors []error) {
 	value := v.(string)
 	if !regexp.MustCompile(`^[a-z0-9-]+$`).MatchString(value) {
@@ -6,7 +6,6 @@ import (
 
 	"github.com/Azure/azure-sdk-for-go/profiles/2017-03-09/resources/mgmt/resources"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -21,6 +20,11 @@ func resourceArmResourceGroup() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: customizeDiffAll(
+			customizeDiffValidateLocation,
+			customizeDiffTagsWithDefaultTags,
+		),
+
 		Schema: map[string]*schema.Schema{
 			"name": resourceGroupNameSchema(),
 
@@ -40,16 +44,16 @@ func resourceArmResourceGroupCreateUpdate(d *schema.ResourceData, meta interface
 	tags := d.Get("tags").(map[string]interface{})
 	parameters := resources.Group{
 		Location: utils.String(location),
-		Tags:     *expandTags(tags),
+		Tags:     *expandTags(meta, tags),
 	}
 	_, err := client.CreateOrUpdate(ctx, name, parameters)
 	if err != nil {
-		return fmt.Errorf("Error creating resource group: %+v", err)
+		return fmt.Errorf("Error creating resource group: %s", armErrorDetail(err))
 	}
 
 	resp, err := client.Get(ctx, name)
 	if err != nil {
-		return fmt.Errorf("Error retrieving resource group: %+v", err)
+		return fmt.Errorf("Error retrieving resource group: %s", armErrorDetail(err))
 	}
 
 	d.SetId(*resp.ID)
@@ -70,13 +74,13 @@ func resourceArmResourceGroupRead(d *schema.ResourceData, meta interface{}) erro
 
 	resp, err := client.Get(ctx, name)
 	if err != nil {
-		if utils.ResponseWasNotFound(resp.Response) {
+		if wasNotFound(resp.Response) {
 			log.Printf("[INFO] Error reading resource group %q - removing from state", d.Id())
 			d.SetId("")
 			return nil
 		}
 
-		return fmt.Errorf("Error reading resource group: %+v", err)
+		return fmt.Errorf("Error reading resource group: %s", armErrorDetail(err))
 	}
 
 	d.Set("name", resp.Name)
@@ -99,19 +103,20 @@ func resourceArmResourceGroupExists(d *schema.ResourceData, meta interface{}) (b
 
 	resp, err := client.Get(ctx, name)
 	if err != nil {
-		if utils.ResponseWasNotFound(resp.Response) {
+		if wasNotFound(resp.Response) {
 			return false, nil
 		}
 
-		return false, fmt.Errorf("Error reading resource group: %+v", err)
+		return false, fmt.Errorf("Error reading resource group: %s", armErrorDetail(err))
 	}
 
 	return true, nil
 }
 
 func resourceArmResourceGroupDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).resourceGroupsClient
-	ctx := meta.(*ArmClient).StopContext
+	armClient := meta.(*ArmClient)
+	client := armClient.resourceGroupsClient
+	ctx := armClient.StopContext
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
@@ -120,22 +125,34 @@ func resourceArmResourceGroupDelete(d *schema.ResourceData, meta interface{}) er
 
 	name := id.ResourceGroup
 
+	if armClient.features.ResourceGroup.PreventDeletionIfContainsResources {
+		resourceList, err := client.ListResources(ctx, name, "", "", nil)
+		if err != nil {
+			return fmt.Errorf("Error listing Resources within Resource Group %q: %+v", name, err)
+		}
+
+		if resources := resourceList.Values(); len(resources) > 0 {
+			return fmt.Errorf("Resource Group %q still contains %d Resource(s), which is preventing its deletion "+
+				"as `features.resource_group.prevent_deletion_if_contains_resources` is set to `true`", name, len(resources))
+		}
+	}
+
 	deleteFuture, err := client.Delete(ctx, name)
 	if err != nil {
-		if response.WasNotFound(deleteFuture.Response()) {
+		if wasNotFoundRaw(deleteFuture.Response()) {
 			return nil
 		}
 
-		return fmt.Errorf("Error deleting Resource Group %q: %+v", name, err)
+		return fmt.Errorf("Error deleting Resource Group %q: %s", name, armErrorDetail(err))
 	}
 
 	err = deleteFuture.WaitForCompletionRef(ctx, client.Client)
 	if err != nil {
-		if response.WasNotFound(deleteFuture.Response()) {
+		if wasNotFoundRaw(deleteFuture.Response()) {
 			return nil
 		}
 
-		return fmt.Errorf("Error deleting Resource Group %q: %+v", name, err)
+		return fmt.Errorf("Error deleting Resource Group %q: %s", name, armErrorDetail(err))
 	}
 
 	return nil
@@ -0,0 +1,66 @@
+package azurestack
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func init() {
+	resource.AddTestSweepers("azurestack_resource_group", &resource.Sweeper{
+		Name: "azurestack_resource_group",
+		F:    testSweepResourceGroups,
+	})
+}
+
+// testSweepResourceGroups deletes every acceptance-test Resource Group left behind in the target
+// Subscription, identified by the "acctestRG-" prefix shared by every acceptance test's
+// azurestack_resource_group. Since Azure Stack Hub cascades a Resource Group's deletion to
+// everything inside it, sweeping at this level alone is sufficient to reclaim the quota consumed
+// by the Load Balancers, Virtual Machines, Storage Accounts and every other resource type
+// acceptance tests create underneath one - there's no need for a sweeper per resource type below
+// the Resource Group.
+func testSweepResourceGroups(region string) error {
+	armClient, err := buildSweeperClient()
+	if err != nil {
+		return err
+	}
+	ctx := armClient.StopContext
+
+	groups, err := armClient.resourceGroupsClient.ListComplete(ctx, "", nil)
+	if err != nil {
+		return fmt.Errorf("Error listing Resource Groups: %+v", err)
+	}
+
+	for groups.NotDone() {
+		group := groups.Value()
+		name := ""
+		if group.Name != nil {
+			name = *group.Name
+		}
+
+		matchesPrefix := strings.HasPrefix(name, "acctestRG-")
+		matchesLocation := group.Location != nil && strings.EqualFold(azureStackNormalizeLocation(*group.Location), azureStackNormalizeLocation(region))
+
+		if matchesPrefix && matchesLocation {
+			log.Printf("[DEBUG] Sweeping Resource Group %q", name)
+
+			future, err := armClient.resourceGroupsClient.Delete(ctx, name)
+			if err != nil {
+				return fmt.Errorf("Error deleting Resource Group %q: %+v", name, err)
+			}
+
+			if err := future.WaitForCompletionRef(ctx, armClient.resourceGroupsClient.Client); err != nil {
+				return fmt.Errorf("Error waiting for deletion of Resource Group %q: %+v", name, err)
+			}
+		}
+
+		if err := groups.NextWithContext(ctx); err != nil {
+			return fmt.Errorf("Error advancing Resource Group listing: %+v", err)
+		}
+	}
+
+	return nil
+}
@@ -74,7 +74,9 @@ func resourceArmRouteCreateUpdate(d *schema.ResourceData, meta interface{}) erro
 	addressPrefix := d.Get("address_prefix").(string)
 	nextHopType := d.Get("next_hop_type").(string)
 
-	azureStackLockByName(rtName, routeTableResourceName)
+	if err := azureStackLockByName(rtName, routeTableResourceName); err != nil {
+		return err
+	}
 	defer azureStackUnlockByName(rtName, routeTableResourceName)
 
 	route := network.Route{
@@ -124,7 +126,7 @@ func resourceArmRouteRead(d *schema.ResourceData, meta interface{}) error {
 
 	resp, err := client.Get(ctx, resGroup, rtName, routeName)
 	if err != nil {
-		if utils.ResponseWasNotFound(resp.Response) {
+		if wasNotFound(resp.Response) {
 			d.SetId("")
 			return nil
 		}
@@ -159,7 +161,9 @@ func resourceArmRouteDelete(d *schema.ResourceData, meta interface{}) error {
 	rtName := id.Path["routeTables"]
 	routeName := id.Path["routes"]
 
-	azureStackLockByName(rtName, routeTableResourceName)
+	if err := azureStackLockByName(rtName, routeTableResourceName); err != nil {
+		return err
+	}
 	defer azureStackUnlockByName(rtName, routeTableResourceName)
 
 	future, err := client.Delete(ctx, resGroup, rtName, routeName)
@@ -24,6 +24,17 @@ func resourceArmStorageAccount() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			{
+				Type:    resourceArmStorageAccountResourceV0().CoreConfigSchema().ImpliedType(),
+				Upgrade: resourceArmStorageAccountStateUpgradeV0,
+				Version: 0,
+			},
+		},
+
+		CustomizeDiff: customizeDiffTagsWithDefaultTags,
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -214,6 +225,120 @@ func resourceArmStorageAccount() *schema.Resource {
 
 }
 
+// resourceArmStorageAccountResourceV0 describes the Schema as it existed before `account_type`
+// was split into the now-`Required` `account_tier` and `account_replication_type` fields, and is
+// used only to decode state stored by that earlier version of the Provider for
+// resourceArmStorageAccountStateUpgradeV0.
+func resourceArmStorageAccountResourceV0() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"resource_group_name": resourceGroupNameDiffSuppressSchema(),
+
+			"location": locationSchema(),
+
+			"account_kind": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"account_type": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"account_tier": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"account_replication_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"account_encryption_source": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"custom_domain": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+
+						"use_subdomain": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+					},
+				},
+			},
+
+			"enable_blob_encryption": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+
+			"primary_location":                 {Type: schema.TypeString, Computed: true},
+			"secondary_location":               {Type: schema.TypeString, Computed: true},
+			"primary_blob_endpoint":            {Type: schema.TypeString, Computed: true},
+			"secondary_blob_endpoint":          {Type: schema.TypeString, Computed: true},
+			"primary_queue_endpoint":           {Type: schema.TypeString, Computed: true},
+			"secondary_queue_endpoint":         {Type: schema.TypeString, Computed: true},
+			"primary_table_endpoint":           {Type: schema.TypeString, Computed: true},
+			"secondary_table_endpoint":         {Type: schema.TypeString, Computed: true},
+			"primary_file_endpoint":            {Type: schema.TypeString, Computed: true},
+			"primary_access_key":               {Type: schema.TypeString, Computed: true},
+			"secondary_access_key":             {Type: schema.TypeString, Computed: true},
+			"primary_connection_string":        {Type: schema.TypeString, Computed: true},
+			"secondary_connection_string":      {Type: schema.TypeString, Computed: true},
+			"primary_blob_connection_string":   {Type: schema.TypeString, Computed: true},
+			"secondary_blob_connection_string": {Type: schema.TypeString, Computed: true},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+// resourceArmStorageAccountStateUpgradeV0 populates `account_tier` and `account_replication_type`
+// from the legacy `account_type` (e.g. "Standard_LRS") for state stored before those fields
+// became `Required`, so that upgrading the Provider doesn't force a spurious recreation of
+// existing Storage Accounts on the next plan.
+func resourceArmStorageAccountStateUpgradeV0(rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+	tier, _ := rawState["account_tier"].(string)
+	replicationType, _ := rawState["account_replication_type"].(string)
+	if tier != "" && replicationType != "" {
+		return rawState, nil
+	}
+
+	accountType, _ := rawState["account_type"].(string)
+	parts := strings.SplitN(accountType, "_", 2)
+	if len(parts) != 2 {
+		return rawState, fmt.Errorf("Unable to determine `account_tier`/`account_replication_type` from `account_type` %q", accountType)
+	}
+
+	rawState["account_tier"] = parts[0]
+	rawState["account_replication_type"] = parts[1]
+
+	return rawState, nil
+}
+
 func resourceArmStorageAccountCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).storageServiceClient
 
@@ -239,7 +364,7 @@ func resourceArmStorageAccountCreate(d *schema.ResourceData, meta interface{}) e
 		Sku: &storage.Sku{
 			Name: storage.SkuName(storageType),
 		},
-		Tags: *expandTags(tags),
+		Tags: *expandTags(meta, tags),
 		Kind: storage.Kind(accountKind),
 
 		// If any paramers are specified withouth the right values this will fail
@@ -320,13 +445,7 @@ func resourceArmStorageAccountUpdate(d *schema.ResourceData, meta interface{}) e
 	if err != nil {
 		return err
 	}
-	storageAccountName := id.Path["storageaccounts"]
-	// https://github.com/terraform-providers/terraform-provider-azurestack/issues/98
-	// it appears the casing of the Resource ID's changed in Azure Stack version 1905
-	// as such we need to confirm both casings
-	if storageAccountName == "" {
-		storageAccountName = id.Path["storageAccounts"]
-	}
+	storageAccountName := id.PathKeyCaseInsensitive("storageAccounts")
 	resourceGroupName := id.ResourceGroup
 
 	accountTier := d.Get("account_tier").(string)
@@ -380,7 +499,7 @@ func resourceArmStorageAccountUpdate(d *schema.ResourceData, meta interface{}) e
 		tags := d.Get("tags").(map[string]interface{})
 
 		opts := storage.AccountUpdateParameters{
-			Tags: *expandTags(tags),
+			Tags: *expandTags(meta, tags),
 		}
 
 		_, err := client.Update(ctx, resourceGroupName, storageAccountName, opts)
@@ -446,18 +565,12 @@ func resourceArmStorageAccountRead(d *schema.ResourceData, meta interface{}) err
 		return err
 	}
 
-	name := id.Path["storageaccounts"]
-	// https://github.com/terraform-providers/terraform-provider-azurestack/issues/98
-	// it appears the casing of the Resource ID's changed in Azure Stack version 1905
-	// as such we need to confirm both casings
-	if name == "" {
-		name = id.Path["storageAccounts"]
-	}
+	name := id.PathKeyCaseInsensitive("storageAccounts")
 	resGroup := id.ResourceGroup
 
 	resp, err := client.GetProperties(ctx, resGroup, name)
 	if err != nil {
-		if utils.ResponseWasNotFound(resp.Response) {
+		if wasNotFound(resp.Response) {
 			d.SetId("")
 			return nil
 		}
@@ -566,13 +679,7 @@ func resourceArmStorageAccountDelete(d *schema.ResourceData, meta interface{}) e
 	if err != nil {
 		return err
 	}
-	name := id.Path["storageaccounts"]
-	// https://github.com/terraform-providers/terraform-provider-azurestack/issues/98
-	// it appears the casing of the Resource ID's changed in Azure Stack version 1905
-	// as such we need to confirm both casings
-	if name == "" {
-		name = id.Path["storageAccounts"]
-	}
+	name := id.PathKeyCaseInsensitive("storageAccounts")
 	resGroup := id.ResourceGroup
 
 	_, err = client.Delete(ctx, resGroup, name)
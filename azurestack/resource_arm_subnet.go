@@ -6,7 +6,6 @@ import (
 
 	"github.com/Azure/azure-sdk-for-go/profiles/2019-03-01/network/mgmt/network"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 var subnetResourceName = "azurestack_subnet"
@@ -41,6 +40,9 @@ func resourceArmSubnet() *schema.Resource {
 				Required: true,
 			},
 
+			// address_prefixes (plural) is not exposed here: SubnetPropertiesFormat on this API
+			// profile only has a singular AddressPrefix field, so there is nothing to upgrade to.
+
 			"network_security_group_id": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -59,12 +61,11 @@ func resourceArmSubnet() *schema.Resource {
 				Set:      schema.HashString,
 			},
 
-			// Not supported for 2017-03-09 profile
-			// "service_endpoints": {
-			// 	Type:     schema.TypeList,
-			// 	Optional: true,
-			// 	Elem:     &schema.Schema{Type: schema.TypeString},
-			// },
+			"service_endpoints": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 		},
 	}
 }
@@ -80,7 +81,9 @@ func resourceArmSubnetCreate(d *schema.ResourceData, meta interface{}) error {
 	resGroup := d.Get("resource_group_name").(string)
 	addressPrefix := d.Get("address_prefix").(string)
 
-	azureStackLockByName(vnetName, virtualNetworkResourceName)
+	if err := azureStackLockByName(vnetName, virtualNetworkResourceName); err != nil {
+		return err
+	}
 	defer azureStackUnlockByName(vnetName, virtualNetworkResourceName)
 
 	properties := network.SubnetPropertiesFormat{
@@ -98,7 +101,9 @@ func resourceArmSubnetCreate(d *schema.ResourceData, meta interface{}) error {
 			return err
 		}
 
-		azureStackLockByName(networkSecurityGroupName, networkSecurityGroupResourceName)
+		if err := azureStackLockByName(networkSecurityGroupName, networkSecurityGroupResourceName); err != nil {
+			return err
+		}
 		defer azureStackUnlockByName(networkSecurityGroupName, networkSecurityGroupResourceName)
 	}
 
@@ -113,17 +118,18 @@ func resourceArmSubnetCreate(d *schema.ResourceData, meta interface{}) error {
 			return err
 		}
 
-		azureStackLockByName(routeTableName, routeTableResourceName)
+		if err := azureStackLockByName(routeTableName, routeTableResourceName); err != nil {
+			return err
+		}
 		defer azureStackUnlockByName(routeTableName, routeTableResourceName)
 	}
 
-	// Not supported for 2017-03-09 profile
-	// serviceEndpoints, serviceEndpointsErr := expandAzureStackServiceEndpoints(d)
-	// if serviceEndpointsErr != nil {
-	// 	return fmt.Errorf("Error Building list of Service Endpoints: %+v", serviceEndpointsErr)
-	// }
+	serviceEndpoints, serviceEndpointsErr := expandAzureStackSubnetServiceEndpoints(d)
+	if serviceEndpointsErr != nil {
+		return fmt.Errorf("Error Building list of Service Endpoints: %+v", serviceEndpointsErr)
+	}
 
-	// properties.ServiceEndpoints = &serviceEndpoints
+	properties.ServiceEndpoints = &serviceEndpoints
 
 	subnet := network.Subnet{
 		Name:                   &name,
@@ -168,7 +174,7 @@ func resourceArmSubnetRead(d *schema.ResourceData, meta interface{}) error {
 	resp, err := client.Get(ctx, resGroup, vnetName, name, "")
 
 	if err != nil {
-		if utils.ResponseWasNotFound(resp.Response) {
+		if wasNotFound(resp.Response) {
 			d.SetId("")
 			return nil
 		}
@@ -195,11 +201,10 @@ func resourceArmSubnetRead(d *schema.ResourceData, meta interface{}) error {
 			return err
 		}
 
-		// Not supported for 2017-03-09 profile
-		// serviceEndpoints := flattenSubnetServiceEndpoints(props.ServiceEndpoints)
-		// if err := d.Set("service_endpoints", serviceEndpoints); err != nil {
-		// 	return err
-		// }
+		serviceEndpoints := flattenSubnetServiceEndpoints(props.ServiceEndpoints)
+		if err := d.Set("service_endpoints", serviceEndpoints); err != nil {
+			return err
+		}
 	}
 
 	return nil
@@ -224,14 +229,20 @@ func resourceArmSubnetDelete(d *schema.ResourceData, meta interface{}) error {
 			return err
 		}
 
-		azureStackLockByName(networkSecurityGroupName, networkSecurityGroupResourceName)
+		if err := azureStackLockByName(networkSecurityGroupName, networkSecurityGroupResourceName); err != nil {
+			return err
+		}
 		defer azureStackUnlockByName(networkSecurityGroupName, networkSecurityGroupResourceName)
 	}
 
-	azureStackLockByName(vnetName, virtualNetworkResourceName)
+	if err := azureStackLockByName(vnetName, virtualNetworkResourceName); err != nil {
+		return err
+	}
 	defer azureStackUnlockByName(vnetName, virtualNetworkResourceName)
 
-	azureStackLockByName(name, subnetResourceName)
+	if err := azureStackLockByName(name, subnetResourceName); err != nil {
+		return err
+	}
 	defer azureStackUnlockByName(name, subnetResourceName)
 
 	if v, ok := d.GetOk("route_table_id"); ok {
@@ -241,7 +252,9 @@ func resourceArmSubnetDelete(d *schema.ResourceData, meta interface{}) error {
 			return err
 		}
 
-		azureStackLockByName(routeTableName, routeTableResourceName)
+		if err := azureStackLockByName(routeTableName, routeTableResourceName); err != nil {
+			return err
+		}
 		defer azureStackUnlockByName(routeTableName, routeTableResourceName)
 
 		// This behaviour is only for AzureStack
@@ -253,7 +266,7 @@ func resourceArmSubnetDelete(d *schema.ResourceData, meta interface{}) error {
 
 		resp, err := client.Get(ctx, resGroup, vnetName, name, "")
 		if err != nil {
-			if utils.ResponseWasNotFound(resp.Response) {
+			if wasNotFound(resp.Response) {
 				d.SetId("")
 				return nil
 			}
@@ -291,37 +304,34 @@ func resourceArmSubnetDelete(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-// Since ServiceEndpointPropertiesFormat is not on the 2017-03-09 profile
-// This will not compile
-
-// func expandAzureStackServiceEndpoints(d *schema.ResourceData) ([]network.ServiceEndpointPropertiesFormat, error) {
-// 	serviceEndpoints := d.Get("service_endpoints").([]interface{})
-// 	enpoints := make([]network.ServiceEndpointPropertiesFormat, 0)
-//
-// 	for _, serviceEndpointsRaw := range serviceEndpoints {
-// 		data := serviceEndpointsRaw.(string)
-//
-// 		endpoint := network.ServiceEndpointPropertiesFormat{
-// 			Service: &data,
-// 		}
-//
-// 		enpoints = append(enpoints, endpoint)
-// 	}
-//
-// 	return enpoints, nil
-// }
-
-// func flattenSubnetServiceEndpoints(serviceEndpoints *[]network.ServiceEndpointPropertiesFormat) []string {
-// 	endpoints := make([]string, 0)
-//
-// 	if serviceEndpoints != nil {
-// 		for _, endpoint := range *serviceEndpoints {
-// 			endpoints = append(endpoints, *endpoint.Service)
-// 		}
-// 	}
-//
-// 	return endpoints
-// }
+func expandAzureStackSubnetServiceEndpoints(d *schema.ResourceData) ([]network.ServiceEndpointPropertiesFormat, error) {
+	serviceEndpoints := d.Get("service_endpoints").([]interface{})
+	endpoints := make([]network.ServiceEndpointPropertiesFormat, 0)
+
+	for _, serviceEndpointsRaw := range serviceEndpoints {
+		data := serviceEndpointsRaw.(string)
+
+		endpoint := network.ServiceEndpointPropertiesFormat{
+			Service: &data,
+		}
+
+		endpoints = append(endpoints, endpoint)
+	}
+
+	return endpoints, nil
+}
+
+func flattenSubnetServiceEndpoints(serviceEndpoints *[]network.ServiceEndpointPropertiesFormat) []string {
+	endpoints := make([]string, 0)
+
+	if serviceEndpoints != nil {
+		for _, endpoint := range *serviceEndpoints {
+			endpoints = append(endpoints, *endpoint.Service)
+		}
+	}
+
+	return endpoints
+}
 
 func flattenSubnetIPConfigurations(ipConfigurations *[]network.IPConfiguration) []string {
 	ips := make([]string, 0)
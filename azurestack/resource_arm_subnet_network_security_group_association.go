@@ -0,0 +1,198 @@
+package azurestack
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/2019-03-01/network/mgmt/network"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurestack/azurestack/helpers/azure"
+)
+
+func resourceArmSubnetNetworkSecurityGroupAssociation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmSubnetNetworkSecurityGroupAssociationCreateUpdate,
+		Read:   resourceArmSubnetNetworkSecurityGroupAssociationRead,
+		Update: resourceArmSubnetNetworkSecurityGroupAssociationCreateUpdate,
+		Delete: resourceArmSubnetNetworkSecurityGroupAssociationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"subnet_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"network_security_group_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+		},
+	}
+}
+
+func resourceArmSubnetNetworkSecurityGroupAssociationCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).subnetClient
+	ctx := meta.(*ArmClient).StopContext
+
+	subnetId := d.Get("subnet_id").(string)
+	networkSecurityGroupId := d.Get("network_security_group_id").(string)
+
+	id, err := parseAzureResourceID(subnetId)
+	if err != nil {
+		return err
+	}
+	resGroup := id.ResourceGroup
+	vnetName := id.Path["virtualNetworks"]
+	subnetName := id.Path["subnets"]
+
+	networkSecurityGroupName, err := parseNetworkSecurityGroupName(networkSecurityGroupId)
+	if err != nil {
+		return err
+	}
+
+	if err := azureStackLockByName(vnetName, virtualNetworkResourceName); err != nil {
+		return err
+	}
+	defer azureStackUnlockByName(vnetName, virtualNetworkResourceName)
+
+	if err := azureStackLockByName(subnetName, subnetResourceName); err != nil {
+		return err
+	}
+	defer azureStackUnlockByName(subnetName, subnetResourceName)
+
+	if err := azureStackLockByName(networkSecurityGroupName, networkSecurityGroupResourceName); err != nil {
+		return err
+	}
+	defer azureStackUnlockByName(networkSecurityGroupName, networkSecurityGroupResourceName)
+
+	// this association has no ARM sub-resource of its own - it's a reference held inside the
+	// parent Subnet - so, like the Network Interface associations, the whole Subnet has to be read
+	// back, modified in place and written back
+	subnet, err := client.Get(ctx, resGroup, vnetName, subnetName, "")
+	if err != nil {
+		return fmt.Errorf("Error retrieving Subnet %q (Virtual Network %q / Resource Group %q): %+v", subnetName, vnetName, resGroup, err)
+	}
+
+	if subnet.SubnetPropertiesFormat == nil {
+		return fmt.Errorf("Error retrieving Subnet %q (Virtual Network %q / Resource Group %q): `properties` was nil", subnetName, vnetName, resGroup)
+	}
+
+	subnet.SubnetPropertiesFormat.NetworkSecurityGroup = &network.SecurityGroup{
+		ID: &networkSecurityGroupId,
+	}
+
+	future, err := client.CreateOrUpdate(ctx, resGroup, vnetName, subnetName, subnet)
+	if err != nil {
+		return fmt.Errorf("Error updating Subnet %q (Virtual Network %q / Resource Group %q): %+v", subnetName, vnetName, resGroup, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for update of Subnet %q (Virtual Network %q / Resource Group %q): %+v", subnetName, vnetName, resGroup, err)
+	}
+
+	d.SetId(subnetId)
+
+	return resourceArmSubnetNetworkSecurityGroupAssociationRead(d, meta)
+}
+
+func resourceArmSubnetNetworkSecurityGroupAssociationRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).subnetClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resGroup := id.ResourceGroup
+	vnetName := id.Path["virtualNetworks"]
+	subnetName := id.Path["subnets"]
+
+	subnet, err := client.Get(ctx, resGroup, vnetName, subnetName, "")
+	if err != nil {
+		if wasNotFound(subnet.Response) {
+			log.Printf("[INFO] Subnet %q not found. Removing from state", subnetName)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Subnet %q (Virtual Network %q / Resource Group %q): %+v", subnetName, vnetName, resGroup, err)
+	}
+
+	props := subnet.SubnetPropertiesFormat
+	if props == nil || props.NetworkSecurityGroup == nil || props.NetworkSecurityGroup.ID == nil {
+		log.Printf("[INFO] Subnet %q has no Network Security Group associated. Removing Subnet Network Security Group Association from state", subnetName)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("subnet_id", d.Id())
+	d.Set("network_security_group_id", props.NetworkSecurityGroup.ID)
+
+	return nil
+}
+
+func resourceArmSubnetNetworkSecurityGroupAssociationDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).subnetClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resGroup := id.ResourceGroup
+	vnetName := id.Path["virtualNetworks"]
+	subnetName := id.Path["subnets"]
+
+	networkSecurityGroupId := d.Get("network_security_group_id").(string)
+	networkSecurityGroupName, err := parseNetworkSecurityGroupName(networkSecurityGroupId)
+	if err != nil {
+		return err
+	}
+
+	if err := azureStackLockByName(vnetName, virtualNetworkResourceName); err != nil {
+		return err
+	}
+	defer azureStackUnlockByName(vnetName, virtualNetworkResourceName)
+
+	if err := azureStackLockByName(subnetName, subnetResourceName); err != nil {
+		return err
+	}
+	defer azureStackUnlockByName(subnetName, subnetResourceName)
+
+	if err := azureStackLockByName(networkSecurityGroupName, networkSecurityGroupResourceName); err != nil {
+		return err
+	}
+	defer azureStackUnlockByName(networkSecurityGroupName, networkSecurityGroupResourceName)
+
+	subnet, err := client.Get(ctx, resGroup, vnetName, subnetName, "")
+	if err != nil {
+		if wasNotFound(subnet.Response) {
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Subnet %q (Virtual Network %q / Resource Group %q): %+v", subnetName, vnetName, resGroup, err)
+	}
+
+	if subnet.SubnetPropertiesFormat == nil {
+		return nil
+	}
+
+	subnet.SubnetPropertiesFormat.NetworkSecurityGroup = nil
+
+	future, err := client.CreateOrUpdate(ctx, resGroup, vnetName, subnetName, subnet)
+	if err != nil {
+		return fmt.Errorf("Error removing Network Security Group association from Subnet %q (Virtual Network %q / Resource Group %q): %+v", subnetName, vnetName, resGroup, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for removal of Network Security Group association from Subnet %q (Virtual Network %q / Resource Group %q): %+v", subnetName, vnetName, resGroup, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,142 @@
+package azurestack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureStackSubnetNetworkSecurityGroupAssociation_basic(t *testing.T) {
+	resourceName := "azurestack_subnet_network_security_group_association.test"
+	ri := acctest.RandInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureStackSubnetNetworkSecurityGroupAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureStackSubnetNetworkSecurityGroupAssociation_basic(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureStackSubnetNetworkSecurityGroupAssociationExists(resourceName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckAzureStackSubnetNetworkSecurityGroupAssociationExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %q", name)
+		}
+
+		subnetId := rs.Primary.Attributes["subnet_id"]
+
+		id, err := parseAzureResourceID(subnetId)
+		if err != nil {
+			return err
+		}
+		resourceGroup := id.ResourceGroup
+		vnetName := id.Path["virtualNetworks"]
+		subnetName := id.Path["subnets"]
+
+		client := testAccProvider.Meta().(*ArmClient).subnetClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, resourceGroup, vnetName, subnetName, "")
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Subnet %q (Virtual Network %q / Resource Group %q) does not exist", subnetName, vnetName, resourceGroup)
+			}
+
+			return fmt.Errorf("Bad: Get on subnetClient: %+v", err)
+		}
+
+		if resp.SubnetPropertiesFormat == nil || resp.SubnetPropertiesFormat.NetworkSecurityGroup == nil {
+			return fmt.Errorf("Bad: Subnet %q (Virtual Network %q / Resource Group %q) has no Network Security Group associated", subnetName, vnetName, resourceGroup)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureStackSubnetNetworkSecurityGroupAssociationDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).subnetClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurestack_subnet_network_security_group_association" {
+			continue
+		}
+
+		subnetId := rs.Primary.Attributes["subnet_id"]
+
+		id, err := parseAzureResourceID(subnetId)
+		if err != nil {
+			return err
+		}
+		resourceGroup := id.ResourceGroup
+		vnetName := id.Path["virtualNetworks"]
+		subnetName := id.Path["subnets"]
+
+		resp, err := client.Get(ctx, resourceGroup, vnetName, subnetName, "")
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+
+			return err
+		}
+
+		if resp.SubnetPropertiesFormat != nil && resp.SubnetPropertiesFormat.NetworkSecurityGroup != nil {
+			return fmt.Errorf("Subnet Network Security Group Association still exists for Subnet %q (Virtual Network %q / Resource Group %q)", subnetName, vnetName, resourceGroup)
+		}
+	}
+
+	return nil
+}
+
+func testAccAzureStackSubnetNetworkSecurityGroupAssociation_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurestack_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurestack_virtual_network" "test" {
+  name                = "acctestvnet-%d"
+  address_space       = ["10.0.0.0/16"]
+  location            = "${azurestack_resource_group.test.location}"
+  resource_group_name = "${azurestack_resource_group.test.name}"
+}
+
+resource "azurestack_subnet" "test" {
+  name                 = "acctestsubnet-%d"
+  resource_group_name  = "${azurestack_resource_group.test.name}"
+  virtual_network_name = "${azurestack_virtual_network.test.name}"
+  address_prefix       = "10.0.1.0/24"
+}
+
+resource "azurestack_network_security_group" "test" {
+  name                = "acctestnsg-%d"
+  location            = "${azurestack_resource_group.test.location}"
+  resource_group_name = "${azurestack_resource_group.test.name}"
+}
+
+resource "azurestack_subnet_network_security_group_association" "test" {
+  subnet_id                 = "${azurestack_subnet.test.id}"
+  network_security_group_id = "${azurestack_network_security_group.test.id}"
+}
+`, rInt, location, rInt, rInt, rInt)
+}
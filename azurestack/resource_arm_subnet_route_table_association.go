@@ -0,0 +1,198 @@
+package azurestack
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/2019-03-01/network/mgmt/network"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurestack/azurestack/helpers/azure"
+)
+
+func resourceArmSubnetRouteTableAssociation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmSubnetRouteTableAssociationCreateUpdate,
+		Read:   resourceArmSubnetRouteTableAssociationRead,
+		Update: resourceArmSubnetRouteTableAssociationCreateUpdate,
+		Delete: resourceArmSubnetRouteTableAssociationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"subnet_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"route_table_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+		},
+	}
+}
+
+func resourceArmSubnetRouteTableAssociationCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).subnetClient
+	ctx := meta.(*ArmClient).StopContext
+
+	subnetId := d.Get("subnet_id").(string)
+	routeTableId := d.Get("route_table_id").(string)
+
+	id, err := parseAzureResourceID(subnetId)
+	if err != nil {
+		return err
+	}
+	resGroup := id.ResourceGroup
+	vnetName := id.Path["virtualNetworks"]
+	subnetName := id.Path["subnets"]
+
+	routeTableName, err := parseRouteTableName(routeTableId)
+	if err != nil {
+		return err
+	}
+
+	if err := azureStackLockByName(vnetName, virtualNetworkResourceName); err != nil {
+		return err
+	}
+	defer azureStackUnlockByName(vnetName, virtualNetworkResourceName)
+
+	if err := azureStackLockByName(subnetName, subnetResourceName); err != nil {
+		return err
+	}
+	defer azureStackUnlockByName(subnetName, subnetResourceName)
+
+	if err := azureStackLockByName(routeTableName, routeTableResourceName); err != nil {
+		return err
+	}
+	defer azureStackUnlockByName(routeTableName, routeTableResourceName)
+
+	// this association has no ARM sub-resource of its own - it's a reference held inside the
+	// parent Subnet - so, like the Network Interface associations, the whole Subnet has to be read
+	// back, modified in place and written back
+	subnet, err := client.Get(ctx, resGroup, vnetName, subnetName, "")
+	if err != nil {
+		return fmt.Errorf("Error retrieving Subnet %q (Virtual Network %q / Resource Group %q): %+v", subnetName, vnetName, resGroup, err)
+	}
+
+	if subnet.SubnetPropertiesFormat == nil {
+		return fmt.Errorf("Error retrieving Subnet %q (Virtual Network %q / Resource Group %q): `properties` was nil", subnetName, vnetName, resGroup)
+	}
+
+	subnet.SubnetPropertiesFormat.RouteTable = &network.RouteTable{
+		ID: &routeTableId,
+	}
+
+	future, err := client.CreateOrUpdate(ctx, resGroup, vnetName, subnetName, subnet)
+	if err != nil {
+		return fmt.Errorf("Error updating Subnet %q (Virtual Network %q / Resource Group %q): %+v", subnetName, vnetName, resGroup, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for update of Subnet %q (Virtual Network %q / Resource Group %q): %+v", subnetName, vnetName, resGroup, err)
+	}
+
+	d.SetId(subnetId)
+
+	return resourceArmSubnetRouteTableAssociationRead(d, meta)
+}
+
+func resourceArmSubnetRouteTableAssociationRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).subnetClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resGroup := id.ResourceGroup
+	vnetName := id.Path["virtualNetworks"]
+	subnetName := id.Path["subnets"]
+
+	subnet, err := client.Get(ctx, resGroup, vnetName, subnetName, "")
+	if err != nil {
+		if wasNotFound(subnet.Response) {
+			log.Printf("[INFO] Subnet %q not found. Removing from state", subnetName)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Subnet %q (Virtual Network %q / Resource Group %q): %+v", subnetName, vnetName, resGroup, err)
+	}
+
+	props := subnet.SubnetPropertiesFormat
+	if props == nil || props.RouteTable == nil || props.RouteTable.ID == nil {
+		log.Printf("[INFO] Subnet %q has no Route Table associated. Removing Subnet Route Table Association from state", subnetName)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("subnet_id", d.Id())
+	d.Set("route_table_id", props.RouteTable.ID)
+
+	return nil
+}
+
+func resourceArmSubnetRouteTableAssociationDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).subnetClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resGroup := id.ResourceGroup
+	vnetName := id.Path["virtualNetworks"]
+	subnetName := id.Path["subnets"]
+
+	routeTableId := d.Get("route_table_id").(string)
+	routeTableName, err := parseRouteTableName(routeTableId)
+	if err != nil {
+		return err
+	}
+
+	if err := azureStackLockByName(vnetName, virtualNetworkResourceName); err != nil {
+		return err
+	}
+	defer azureStackUnlockByName(vnetName, virtualNetworkResourceName)
+
+	if err := azureStackLockByName(subnetName, subnetResourceName); err != nil {
+		return err
+	}
+	defer azureStackUnlockByName(subnetName, subnetResourceName)
+
+	if err := azureStackLockByName(routeTableName, routeTableResourceName); err != nil {
+		return err
+	}
+	defer azureStackUnlockByName(routeTableName, routeTableResourceName)
+
+	subnet, err := client.Get(ctx, resGroup, vnetName, subnetName, "")
+	if err != nil {
+		if wasNotFound(subnet.Response) {
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Subnet %q (Virtual Network %q / Resource Group %q): %+v", subnetName, vnetName, resGroup, err)
+	}
+
+	if subnet.SubnetPropertiesFormat == nil {
+		return nil
+	}
+
+	subnet.SubnetPropertiesFormat.RouteTable = nil
+
+	future, err := client.CreateOrUpdate(ctx, resGroup, vnetName, subnetName, subnet)
+	if err != nil {
+		return fmt.Errorf("Error removing Route Table association from Subnet %q (Virtual Network %q / Resource Group %q): %+v", subnetName, vnetName, resGroup, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for removal of Route Table association from Subnet %q (Virtual Network %q / Resource Group %q): %+v", subnetName, vnetName, resGroup, err)
+	}
+
+	return nil
+}
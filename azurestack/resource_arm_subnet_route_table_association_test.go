@@ -0,0 +1,142 @@
+package azurestack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureStackSubnetRouteTableAssociation_basic(t *testing.T) {
+	resourceName := "azurestack_subnet_route_table_association.test"
+	ri := acctest.RandInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureStackSubnetRouteTableAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureStackSubnetRouteTableAssociation_basic(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureStackSubnetRouteTableAssociationExists(resourceName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckAzureStackSubnetRouteTableAssociationExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %q", name)
+		}
+
+		subnetId := rs.Primary.Attributes["subnet_id"]
+
+		id, err := parseAzureResourceID(subnetId)
+		if err != nil {
+			return err
+		}
+		resourceGroup := id.ResourceGroup
+		vnetName := id.Path["virtualNetworks"]
+		subnetName := id.Path["subnets"]
+
+		client := testAccProvider.Meta().(*ArmClient).subnetClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, resourceGroup, vnetName, subnetName, "")
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Subnet %q (Virtual Network %q / Resource Group %q) does not exist", subnetName, vnetName, resourceGroup)
+			}
+
+			return fmt.Errorf("Bad: Get on subnetClient: %+v", err)
+		}
+
+		if resp.SubnetPropertiesFormat == nil || resp.SubnetPropertiesFormat.RouteTable == nil {
+			return fmt.Errorf("Bad: Subnet %q (Virtual Network %q / Resource Group %q) has no Route Table associated", subnetName, vnetName, resourceGroup)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureStackSubnetRouteTableAssociationDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).subnetClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurestack_subnet_route_table_association" {
+			continue
+		}
+
+		subnetId := rs.Primary.Attributes["subnet_id"]
+
+		id, err := parseAzureResourceID(subnetId)
+		if err != nil {
+			return err
+		}
+		resourceGroup := id.ResourceGroup
+		vnetName := id.Path["virtualNetworks"]
+		subnetName := id.Path["subnets"]
+
+		resp, err := client.Get(ctx, resourceGroup, vnetName, subnetName, "")
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+
+			return err
+		}
+
+		if resp.SubnetPropertiesFormat != nil && resp.SubnetPropertiesFormat.RouteTable != nil {
+			return fmt.Errorf("Subnet Route Table Association still exists for Subnet %q (Virtual Network %q / Resource Group %q)", subnetName, vnetName, resourceGroup)
+		}
+	}
+
+	return nil
+}
+
+func testAccAzureStackSubnetRouteTableAssociation_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurestack_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurestack_virtual_network" "test" {
+  name                = "acctestvnet-%d"
+  address_space       = ["10.0.0.0/16"]
+  location            = "${azurestack_resource_group.test.location}"
+  resource_group_name = "${azurestack_resource_group.test.name}"
+}
+
+resource "azurestack_subnet" "test" {
+  name                 = "acctestsubnet-%d"
+  resource_group_name  = "${azurestack_resource_group.test.name}"
+  virtual_network_name = "${azurestack_virtual_network.test.name}"
+  address_prefix       = "10.0.1.0/24"
+}
+
+resource "azurestack_route_table" "test" {
+  name                = "acctestroutetable-%d"
+  location            = "${azurestack_resource_group.test.location}"
+  resource_group_name = "${azurestack_resource_group.test.name}"
+}
+
+resource "azurestack_subnet_route_table_association" "test" {
+  subnet_id      = "${azurestack_subnet.test.id}"
+  route_table_id = "${azurestack_route_table.test.id}"
+}
+`, rInt, location, rInt, rInt, rInt)
+}
@@ -350,9 +350,6 @@ func testCheckAzureStackSubnetDestroy(s *terraform.State) error {
 
 // Not supported for 2017-03-09 profile
 func TestAccAzureStackSubnet_serviceEndpoints(t *testing.T) {
-
-	t.Skip()
-
 	ri := acctest.RandInt()
 	config := testAccAzureStackSubnet_serviceEndpoints(ri, testLocation())
 
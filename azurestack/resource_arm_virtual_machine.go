@@ -25,6 +25,8 @@ func resourceArmVirtualMachine() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: customizeDiffTagsWithDefaultTags,
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -511,7 +513,7 @@ func resourceArmVirtualMachineCreate(d *schema.ResourceData, meta interface{}) e
 	location := azureStackNormalizeLocation(d.Get("location").(string))
 	resGroup := d.Get("resource_group_name").(string)
 	tags := d.Get("tags").(map[string]interface{})
-	expandedTags := expandTags(tags)
+	expandedTags := expandTags(meta, tags)
 	// zones := expandZones(d.Get("zones").([]interface{}))
 
 	osDisk, err := expandAzureStackVirtualMachineOsDisk(d)
@@ -637,7 +639,7 @@ func resourceArmVirtualMachineRead(d *schema.ResourceData, meta interface{}) err
 
 	resp, err := vmClient.Get(ctx, resGroup, name, "")
 	if err != nil {
-		if utils.ResponseWasNotFound(resp.Response) {
+		if wasNotFound(resp.Response) {
 			d.SetId("")
 			return nil
 		}
@@ -750,8 +752,9 @@ func resourceArmVirtualMachineRead(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceArmVirtualMachineDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).vmClient
-	ctx := meta.(*ArmClient).StopContext
+	armClient := meta.(*ArmClient)
+	client := armClient.vmClient
+	ctx := armClient.StopContext
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
@@ -770,8 +773,9 @@ func resourceArmVirtualMachineDelete(d *schema.ResourceData, meta interface{}) e
 		return err
 	}
 
-	// delete OS Disk if opted in
-	if deleteOsDisk := d.Get("delete_os_disk_on_termination").(bool); deleteOsDisk {
+	// delete OS Disk if opted in, either on the resource itself or via the Provider's `features` block
+	deleteOsDisk := d.Get("delete_os_disk_on_termination").(bool) || armClient.features.VirtualMachine.DeleteOSDiskOnDeletion
+	if deleteOsDisk {
 		log.Printf("[INFO] delete_os_disk_on_termination is enabled, deleting disk from %s", name)
 
 		osDisk, err := expandAzureStackVirtualMachineOsDisk(d)
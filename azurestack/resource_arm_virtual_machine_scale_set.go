@@ -16,6 +16,8 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurestack/azurestack/helpers/azure"
 )
 
+var virtualMachineScaleSetResourceName = "azurestack_virtual_machine_scale_set"
+
 func resourceArmVirtualMachineScaleSet() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceArmVirtualMachineScaleSetCreate,
@@ -26,6 +28,8 @@ func resourceArmVirtualMachineScaleSet() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: customizeDiffTagsWithDefaultTags,
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -698,7 +702,7 @@ func resourceArmVirtualMachineScaleSetCreate(d *schema.ResourceData, meta interf
 	properties := compute.VirtualMachineScaleSet{
 		Name:                             &name,
 		Location:                         &location,
-		Tags:                             *expandTags(tags),
+		Tags:                             *expandTags(meta, tags),
 		Sku:                              sku,
 		VirtualMachineScaleSetProperties: &scaleSetProps,
 	}
@@ -742,7 +746,7 @@ func resourceArmVirtualMachineScaleSetRead(d *schema.ResourceData, meta interfac
 
 	resp, err := client.Get(ctx, resGroup, name)
 	if err != nil {
-		if utils.ResponseWasNotFound(resp.Response) {
+		if wasNotFound(resp.Response) {
 			log.Printf("[INFO] AzureStack Virtual Machine Scale Set (%s) Not Found. Removing from State", name)
 			d.SetId("")
 			return nil
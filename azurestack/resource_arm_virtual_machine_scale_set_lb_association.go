@@ -0,0 +1,317 @@
+package azurestack
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/2019-03-01/compute/mgmt/compute"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+	"github.com/terraform-providers/terraform-provider-azurestack/azurestack/helpers/azure"
+)
+
+func resourceArmVirtualMachineScaleSetLoadBalancerAssociation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmVirtualMachineScaleSetLoadBalancerAssociationCreate,
+		Read:   resourceArmVirtualMachineScaleSetLoadBalancerAssociationRead,
+		Delete: resourceArmVirtualMachineScaleSetLoadBalancerAssociationDelete,
+
+		CustomizeDiff: customizeDiffAtLeastOne("backend_address_pool_id", "nat_pool_id"),
+
+		Schema: map[string]*schema.Schema{
+			"virtual_machine_scale_set_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"network_interface_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"ip_configuration_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"backend_address_pool_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"nat_pool_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+		},
+	}
+}
+
+// virtualMachineScaleSetLoadBalancerAssociationID is a synthetic, non-ARM ID - there's no ARM
+// sub-resource representing this association, so its identity is defined here as the tuple that
+// locates it inside the parent scale set's Network Profile.
+func virtualMachineScaleSetLoadBalancerAssociationID(scaleSetID, nicName, ipConfigName, backendPoolID, natPoolID string) string {
+	return strings.Join([]string{scaleSetID, nicName, ipConfigName, backendPoolID, natPoolID}, "|")
+}
+
+func parseVirtualMachineScaleSetLoadBalancerAssociationID(id string) (scaleSetID, nicName, ipConfigName, backendPoolID, natPoolID string, err error) {
+	parts := strings.Split(id, "|")
+	if len(parts) != 5 {
+		return "", "", "", "", "", fmt.Errorf("Error parsing Virtual Machine Scale Set LoadBalancer Association ID %q: expected 5 `|`-separated segments", id)
+	}
+
+	return parts[0], parts[1], parts[2], parts[3], parts[4], nil
+}
+
+func resourceArmVirtualMachineScaleSetLoadBalancerAssociationCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).vmScaleSetClient
+	ctx := meta.(*ArmClient).StopContext
+
+	scaleSetID := d.Get("virtual_machine_scale_set_id").(string)
+	nicName := d.Get("network_interface_name").(string)
+	ipConfigName := d.Get("ip_configuration_name").(string)
+	backendPoolID := d.Get("backend_address_pool_id").(string)
+	natPoolID := d.Get("nat_pool_id").(string)
+
+	id, err := parseAzureResourceID(scaleSetID)
+	if err != nil {
+		return err
+	}
+	resGroup := id.ResourceGroup
+	scaleSetName := id.Path["virtualMachineScaleSets"]
+
+	if err := azureStackLockByName(scaleSetName, virtualMachineScaleSetResourceName); err != nil {
+		return err
+	}
+	defer azureStackUnlockByName(scaleSetName, virtualMachineScaleSetResourceName)
+
+	// this association has no ARM sub-resource of its own - it's a reference held inside the parent
+	// scale set's Network Profile - so, unlike the LoadBalancer sub-resources, there's no per-write
+	// endpoint to target: the whole scale set has to be read back, modified in place and written back
+	scaleSet, err := client.Get(ctx, resGroup, scaleSetName)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Virtual Machine Scale Set %q (Resource Group %q): %+v", scaleSetName, resGroup, err)
+	}
+
+	ipConfig, err := findVirtualMachineScaleSetIPConfiguration(&scaleSet, nicName, ipConfigName)
+	if err != nil {
+		return err
+	}
+
+	if backendPoolID != "" {
+		ipConfig.LoadBalancerBackendAddressPools = addSubResourceID(ipConfig.LoadBalancerBackendAddressPools, backendPoolID)
+	}
+
+	if natPoolID != "" {
+		ipConfig.LoadBalancerInboundNatPools = addSubResourceID(ipConfig.LoadBalancerInboundNatPools, natPoolID)
+	}
+
+	future, err := client.CreateOrUpdate(ctx, resGroup, scaleSetName, scaleSet)
+	if err != nil {
+		return fmt.Errorf("Error updating Virtual Machine Scale Set %q (Resource Group %q): %+v", scaleSetName, resGroup, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for update of Virtual Machine Scale Set %q (Resource Group %q): %+v", scaleSetName, resGroup, err)
+	}
+
+	d.SetId(virtualMachineScaleSetLoadBalancerAssociationID(scaleSetID, nicName, ipConfigName, backendPoolID, natPoolID))
+
+	return resourceArmVirtualMachineScaleSetLoadBalancerAssociationRead(d, meta)
+}
+
+func resourceArmVirtualMachineScaleSetLoadBalancerAssociationRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).vmScaleSetClient
+	ctx := meta.(*ArmClient).StopContext
+
+	scaleSetID, nicName, ipConfigName, backendPoolID, natPoolID, err := parseVirtualMachineScaleSetLoadBalancerAssociationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	id, err := parseAzureResourceID(scaleSetID)
+	if err != nil {
+		return err
+	}
+	resGroup := id.ResourceGroup
+	scaleSetName := id.Path["virtualMachineScaleSets"]
+
+	scaleSet, err := client.Get(ctx, resGroup, scaleSetName)
+	if err != nil {
+		if wasNotFound(scaleSet.Response) {
+			log.Printf("[INFO] Virtual Machine Scale Set %q not found. Removing from state", scaleSetName)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Virtual Machine Scale Set %q (Resource Group %q): %+v", scaleSetName, resGroup, err)
+	}
+
+	ipConfig, err := findVirtualMachineScaleSetIPConfiguration(&scaleSet, nicName, ipConfigName)
+	if err != nil {
+		log.Printf("[INFO] %s. Removing Virtual Machine Scale Set LoadBalancer Association from state", err)
+		d.SetId("")
+		return nil
+	}
+
+	if backendPoolID != "" && !hasSubResourceID(ipConfig.LoadBalancerBackendAddressPools, backendPoolID) {
+		log.Printf("[INFO] Backend Address Pool %q is no longer associated. Removing Virtual Machine Scale Set LoadBalancer Association from state", backendPoolID)
+		d.SetId("")
+		return nil
+	}
+
+	if natPoolID != "" && !hasSubResourceID(ipConfig.LoadBalancerInboundNatPools, natPoolID) {
+		log.Printf("[INFO] NAT Pool %q is no longer associated. Removing Virtual Machine Scale Set LoadBalancer Association from state", natPoolID)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("virtual_machine_scale_set_id", scaleSetID)
+	d.Set("network_interface_name", nicName)
+	d.Set("ip_configuration_name", ipConfigName)
+	d.Set("backend_address_pool_id", backendPoolID)
+	d.Set("nat_pool_id", natPoolID)
+
+	return nil
+}
+
+func resourceArmVirtualMachineScaleSetLoadBalancerAssociationDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).vmScaleSetClient
+	ctx := meta.(*ArmClient).StopContext
+
+	scaleSetID, nicName, ipConfigName, backendPoolID, natPoolID, err := parseVirtualMachineScaleSetLoadBalancerAssociationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	id, err := parseAzureResourceID(scaleSetID)
+	if err != nil {
+		return err
+	}
+	resGroup := id.ResourceGroup
+	scaleSetName := id.Path["virtualMachineScaleSets"]
+
+	if err := azureStackLockByName(scaleSetName, virtualMachineScaleSetResourceName); err != nil {
+		return err
+	}
+	defer azureStackUnlockByName(scaleSetName, virtualMachineScaleSetResourceName)
+
+	scaleSet, err := client.Get(ctx, resGroup, scaleSetName)
+	if err != nil {
+		if wasNotFound(scaleSet.Response) {
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Virtual Machine Scale Set %q (Resource Group %q): %+v", scaleSetName, resGroup, err)
+	}
+
+	ipConfig, err := findVirtualMachineScaleSetIPConfiguration(&scaleSet, nicName, ipConfigName)
+	if err != nil {
+		return nil
+	}
+
+	if backendPoolID != "" {
+		ipConfig.LoadBalancerBackendAddressPools = removeSubResourceID(ipConfig.LoadBalancerBackendAddressPools, backendPoolID)
+	}
+
+	if natPoolID != "" {
+		ipConfig.LoadBalancerInboundNatPools = removeSubResourceID(ipConfig.LoadBalancerInboundNatPools, natPoolID)
+	}
+
+	future, err := client.CreateOrUpdate(ctx, resGroup, scaleSetName, scaleSet)
+	if err != nil {
+		return fmt.Errorf("Error updating Virtual Machine Scale Set %q (Resource Group %q): %+v", scaleSetName, resGroup, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for update of Virtual Machine Scale Set %q (Resource Group %q): %+v", scaleSetName, resGroup, err)
+	}
+
+	return nil
+}
+
+// findVirtualMachineScaleSetIPConfiguration returns a pointer into scaleSet's own Network Profile,
+// so that the caller can mutate it in place before writing the whole scale set back.
+func findVirtualMachineScaleSetIPConfiguration(scaleSet *compute.VirtualMachineScaleSet, nicName, ipConfigName string) (*compute.VirtualMachineScaleSetIPConfigurationProperties, error) {
+	if scaleSet.VirtualMachineScaleSetProperties == nil || scaleSet.VirtualMachineProfile == nil || scaleSet.VirtualMachineProfile.NetworkProfile == nil {
+		return nil, fmt.Errorf("Virtual Machine Scale Set %q has no Network Profile", *scaleSet.Name)
+	}
+
+	nicConfigs := scaleSet.VirtualMachineProfile.NetworkProfile.NetworkInterfaceConfigurations
+	if nicConfigs == nil {
+		return nil, fmt.Errorf("Virtual Machine Scale Set %q has no Network Interface Configurations", *scaleSet.Name)
+	}
+
+	for _, nicConfig := range *nicConfigs {
+		if nicConfig.Name == nil || *nicConfig.Name != nicName || nicConfig.VirtualMachineScaleSetNetworkConfigurationProperties == nil {
+			continue
+		}
+
+		ipConfigs := nicConfig.VirtualMachineScaleSetNetworkConfigurationProperties.IPConfigurations
+		if ipConfigs == nil {
+			continue
+		}
+
+		for _, ipConfig := range *ipConfigs {
+			if ipConfig.Name != nil && *ipConfig.Name == ipConfigName {
+				// ipConfig is a copy of the slice element, but its embedded properties field is
+				// itself a pointer to the same underlying struct - mutating through it still
+				// mutates scaleSet's own Network Profile in place
+				return ipConfig.VirtualMachineScaleSetIPConfigurationProperties, nil
+			}
+		}
+	}
+
+	return nil, fmt.Errorf("IP Configuration %q (Network Interface %q) was not found on Virtual Machine Scale Set %q", ipConfigName, nicName, *scaleSet.Name)
+}
+
+func addSubResourceID(existing *[]compute.SubResource, id string) *[]compute.SubResource {
+	if existing == nil {
+		return &[]compute.SubResource{{ID: utils.String(id)}}
+	}
+
+	if hasSubResourceID(existing, id) {
+		return existing
+	}
+
+	updated := append(*existing, compute.SubResource{ID: utils.String(id)})
+	return &updated
+}
+
+func removeSubResourceID(existing *[]compute.SubResource, id string) *[]compute.SubResource {
+	if existing == nil {
+		return existing
+	}
+
+	updated := make([]compute.SubResource, 0, len(*existing))
+	for _, subResource := range *existing {
+		if subResource.ID == nil || *subResource.ID != id {
+			updated = append(updated, subResource)
+		}
+	}
+
+	return &updated
+}
+
+func hasSubResourceID(existing *[]compute.SubResource, id string) bool {
+	if existing == nil {
+		return false
+	}
+
+	for _, subResource := range *existing {
+		if subResource.ID != nil && *subResource.ID == id {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,171 @@
+package azurestack
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/2019-03-01/network/mgmt/network"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurestack/azurestack/helpers/azure"
+)
+
+func resourceArmVirtualNetworkDnsServers() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmVirtualNetworkDnsServersCreateUpdate,
+		Read:   resourceArmVirtualNetworkDnsServersRead,
+		Update: resourceArmVirtualNetworkDnsServersCreateUpdate,
+		Delete: resourceArmVirtualNetworkDnsServersDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"virtual_network_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"dns_servers": {
+				Type:     schema.TypeList,
+				Required: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func resourceArmVirtualNetworkDnsServersCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).vnetClient
+	ctx := meta.(*ArmClient).StopContext
+
+	virtualNetworkId := d.Get("virtual_network_id").(string)
+
+	id, err := parseAzureResourceID(virtualNetworkId)
+	if err != nil {
+		return err
+	}
+	resGroup := id.ResourceGroup
+	vnetName := id.Path["virtualNetworks"]
+
+	dnsServers := make([]string, 0)
+	for _, v := range d.Get("dns_servers").([]interface{}) {
+		dnsServers = append(dnsServers, v.(string))
+	}
+
+	if err := azureStackLockByName(vnetName, virtualNetworkResourceName); err != nil {
+		return err
+	}
+	defer azureStackUnlockByName(vnetName, virtualNetworkResourceName)
+
+	// this resource has no ARM sub-resource of its own - it's a reference held inside the
+	// parent Virtual Network - so, like the Subnet associations, the whole Virtual Network has
+	// to be read back, modified in place and written back
+	vnet, err := client.Get(ctx, resGroup, vnetName, "")
+	if err != nil {
+		return fmt.Errorf("Error retrieving Virtual Network %q (Resource Group %q): %+v", vnetName, resGroup, err)
+	}
+
+	if vnet.VirtualNetworkPropertiesFormat == nil {
+		return fmt.Errorf("Error retrieving Virtual Network %q (Resource Group %q): `properties` was nil", vnetName, resGroup)
+	}
+
+	vnet.VirtualNetworkPropertiesFormat.DhcpOptions = &network.DhcpOptions{
+		DNSServers: &dnsServers,
+	}
+
+	future, err := client.CreateOrUpdate(ctx, resGroup, vnetName, vnet)
+	if err != nil {
+		return fmt.Errorf("Error updating Virtual Network %q (Resource Group %q): %+v", vnetName, resGroup, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for update of Virtual Network %q (Resource Group %q): %+v", vnetName, resGroup, err)
+	}
+
+	d.SetId(virtualNetworkId)
+
+	return resourceArmVirtualNetworkDnsServersRead(d, meta)
+}
+
+func resourceArmVirtualNetworkDnsServersRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).vnetClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resGroup := id.ResourceGroup
+	vnetName := id.Path["virtualNetworks"]
+
+	vnet, err := client.Get(ctx, resGroup, vnetName, "")
+	if err != nil {
+		if wasNotFound(vnet.Response) {
+			log.Printf("[INFO] Virtual Network %q not found. Removing from state", vnetName)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Virtual Network %q (Resource Group %q): %+v", vnetName, resGroup, err)
+	}
+
+	d.Set("virtual_network_id", d.Id())
+
+	dnsServers := make([]string, 0)
+	if props := vnet.VirtualNetworkPropertiesFormat; props != nil && props.DhcpOptions != nil && props.DhcpOptions.DNSServers != nil {
+		dnsServers = *props.DhcpOptions.DNSServers
+	}
+
+	if err := d.Set("dns_servers", dnsServers); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func resourceArmVirtualNetworkDnsServersDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).vnetClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resGroup := id.ResourceGroup
+	vnetName := id.Path["virtualNetworks"]
+
+	if err := azureStackLockByName(vnetName, virtualNetworkResourceName); err != nil {
+		return err
+	}
+	defer azureStackUnlockByName(vnetName, virtualNetworkResourceName)
+
+	vnet, err := client.Get(ctx, resGroup, vnetName, "")
+	if err != nil {
+		if wasNotFound(vnet.Response) {
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Virtual Network %q (Resource Group %q): %+v", vnetName, resGroup, err)
+	}
+
+	if vnet.VirtualNetworkPropertiesFormat == nil {
+		return nil
+	}
+
+	vnet.VirtualNetworkPropertiesFormat.DhcpOptions = nil
+
+	future, err := client.CreateOrUpdate(ctx, resGroup, vnetName, vnet)
+	if err != nil {
+		return fmt.Errorf("Error removing DNS Servers from Virtual Network %q (Resource Group %q): %+v", vnetName, resGroup, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for removal of DNS Servers from Virtual Network %q (Resource Group %q): %+v", vnetName, resGroup, err)
+	}
+
+	return nil
+}
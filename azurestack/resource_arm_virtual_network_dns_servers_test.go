@@ -0,0 +1,127 @@
+package azurestack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureStackVirtualNetworkDnsServers_basic(t *testing.T) {
+	resourceName := "azurestack_virtual_network_dns_servers.test"
+	ri := acctest.RandInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureStackVirtualNetworkDnsServersDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureStackVirtualNetworkDnsServers_basic(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureStackVirtualNetworkDnsServersExists(resourceName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckAzureStackVirtualNetworkDnsServersExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %q", name)
+		}
+
+		vnetId := rs.Primary.Attributes["virtual_network_id"]
+
+		id, err := parseAzureResourceID(vnetId)
+		if err != nil {
+			return err
+		}
+		resourceGroup := id.ResourceGroup
+		vnetName := id.Path["virtualNetworks"]
+
+		client := testAccProvider.Meta().(*ArmClient).vnetClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, resourceGroup, vnetName, "")
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Virtual Network %q (Resource Group %q) does not exist", vnetName, resourceGroup)
+			}
+
+			return fmt.Errorf("Bad: Get on vnetClient: %+v", err)
+		}
+
+		if resp.VirtualNetworkPropertiesFormat == nil || resp.VirtualNetworkPropertiesFormat.DhcpOptions == nil || resp.VirtualNetworkPropertiesFormat.DhcpOptions.DNSServers == nil {
+			return fmt.Errorf("Bad: Virtual Network %q (Resource Group %q) has no DNS Servers associated", vnetName, resourceGroup)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureStackVirtualNetworkDnsServersDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).vnetClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurestack_virtual_network_dns_servers" {
+			continue
+		}
+
+		vnetId := rs.Primary.Attributes["virtual_network_id"]
+
+		id, err := parseAzureResourceID(vnetId)
+		if err != nil {
+			return err
+		}
+		resourceGroup := id.ResourceGroup
+		vnetName := id.Path["virtualNetworks"]
+
+		resp, err := client.Get(ctx, resourceGroup, vnetName, "")
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+
+			return err
+		}
+
+		if resp.VirtualNetworkPropertiesFormat != nil && resp.VirtualNetworkPropertiesFormat.DhcpOptions != nil {
+			return fmt.Errorf("Virtual Network DNS Servers still exist for Virtual Network %q (Resource Group %q)", vnetName, resourceGroup)
+		}
+	}
+
+	return nil
+}
+
+func testAccAzureStackVirtualNetworkDnsServers_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurestack_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurestack_virtual_network" "test" {
+  name                = "acctestvnet-%d"
+  address_space       = ["10.0.0.0/16"]
+  location            = "${azurestack_resource_group.test.location}"
+  resource_group_name = "${azurestack_resource_group.test.name}"
+}
+
+resource "azurestack_virtual_network_dns_servers" "test" {
+  virtual_network_id = "${azurestack_virtual_network.test.id}"
+  dns_servers         = ["8.8.8.8", "8.8.4.4"]
+}
+`, rInt, location, rInt)
+}
@@ -25,7 +25,10 @@ func resourceArmVirtualNetworkGateway() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
-		CustomizeDiff: resourceArmVirtualNetworkGatewayCustomizeDiff,
+		CustomizeDiff: customizeDiffAll(
+			resourceArmVirtualNetworkGatewayCustomizeDiff,
+			customizeDiffTagsWithDefaultTags,
+		),
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -289,7 +292,7 @@ func resourceArmVirtualNetworkGatewayCreateUpdate(d *schema.ResourceData, meta i
 	gateway := network.VirtualNetworkGateway{
 		Name:                                  &name,
 		Location:                              &location,
-		Tags:                                  *expandTags(tags),
+		Tags:                                  *expandTags(meta, tags),
 		VirtualNetworkGatewayPropertiesFormat: properties,
 	}
 
@@ -326,7 +329,7 @@ func resourceArmVirtualNetworkGatewayRead(d *schema.ResourceData, meta interface
 
 	resp, err := client.Get(ctx, resGroup, name)
 	if err != nil {
-		if utils.ResponseWasNotFound(resp.Response) {
+		if wasNotFound(resp.Response) {
 			d.SetId("")
 			return nil
 		}
@@ -16,12 +16,19 @@ func resourceArmVirtualNetworkGatewayConnection() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceArmVirtualNetworkGatewayConnectionCreateUpdate,
 		Read:   resourceArmVirtualNetworkGatewayConnectionRead,
-		Update: resourceArmVirtualNetworkGatewayConnectionCreateUpdate,
+		Update: resourceArmVirtualNetworkGatewayConnectionUpdate,
 		Delete: resourceArmVirtualNetworkGatewayConnectionDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: customizeDiffAll(
+			customizeDiffRequiredWithValue("type", string(network.ExpressRoute), "express_route_circuit_id"),
+			customizeDiffRequiredWithValue("type", string(network.IPsec), "local_network_gateway_id", "shared_key"),
+			customizeDiffRequiredWithValue("type", string(network.Vnet2Vnet), "peer_virtual_network_gateway_id"),
+			customizeDiffTagsWithDefaultTags,
+		),
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -99,6 +106,116 @@ func resourceArmVirtualNetworkGatewayConnection() *schema.Resource {
 				Computed:  true,
 				Sensitive: true,
 			},
+
+			"use_policy_based_traffic_selectors": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+
+			"ipsec_policy": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"dh_group": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(network.None),
+								string(network.DHGroup1),
+								string(network.DHGroup14),
+								string(network.DHGroup2),
+								string(network.DHGroup2048),
+								string(network.DHGroup24),
+								string(network.ECP256),
+								string(network.ECP384),
+							}, false),
+						},
+
+						"ike_encryption": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(network.AES128),
+								string(network.AES192),
+								string(network.AES256),
+								string(network.DES),
+								string(network.DES3),
+							}, false),
+						},
+
+						"ike_integrity": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(network.MD5),
+								string(network.SHA1),
+								string(network.SHA256),
+								string(network.SHA384),
+							}, false),
+						},
+
+						"ipsec_encryption": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(network.IpsecEncryptionAES128),
+								string(network.IpsecEncryptionAES192),
+								string(network.IpsecEncryptionAES256),
+								string(network.IpsecEncryptionDES),
+								string(network.IpsecEncryptionDES3),
+								string(network.IpsecEncryptionGCMAES128),
+								string(network.IpsecEncryptionGCMAES192),
+								string(network.IpsecEncryptionGCMAES256),
+								string(network.IpsecEncryptionNone),
+							}, false),
+						},
+
+						"ipsec_integrity": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(network.IpsecIntegrityGCMAES128),
+								string(network.IpsecIntegrityGCMAES192),
+								string(network.IpsecIntegrityGCMAES256),
+								string(network.IpsecIntegrityMD5),
+								string(network.IpsecIntegritySHA1),
+								string(network.IpsecIntegritySHA256),
+							}, false),
+						},
+
+						"pfs_group": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(network.PfsGroupECP256),
+								string(network.PfsGroupECP384),
+								string(network.PfsGroupNone),
+								string(network.PfsGroupPFS1),
+								string(network.PfsGroupPFS2),
+								string(network.PfsGroupPFS2048),
+								string(network.PfsGroupPFS24),
+							}, false),
+						},
+
+						"sa_datasize": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      102400000,
+							ValidateFunc: validation.IntAtLeast(1024),
+						},
+
+						"sa_lifetime": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      27000,
+							ValidateFunc: validation.IntAtLeast(300),
+						},
+					},
+				},
+			},
+
 			"tags": tagsSchema(),
 		},
 	}
@@ -123,7 +240,7 @@ func resourceArmVirtualNetworkGatewayConnectionCreateUpdate(d *schema.ResourceDa
 	connection := network.VirtualNetworkGatewayConnection{
 		Name:     &name,
 		Location: &location,
-		Tags:     *expandTags(tags),
+		Tags:     *expandTags(meta, tags),
 		VirtualNetworkGatewayConnectionPropertiesFormat: properties,
 	}
 
@@ -149,6 +266,44 @@ func resourceArmVirtualNetworkGatewayConnectionCreateUpdate(d *schema.ResourceDa
 	return resourceArmVirtualNetworkGatewayConnectionRead(d, meta)
 }
 
+func resourceArmVirtualNetworkGatewayConnectionUpdate(d *schema.ResourceData, meta interface{}) error {
+	// key rotation is the only thing that changed - update it in place with the
+	// dedicated API rather than a full CreateOrUpdate, so the tunnel isn't dropped
+	if d.HasChange("shared_key") && !d.HasChange("authorization_key") && !d.HasChange("local_network_gateway_id") &&
+		!d.HasChange("enable_bgp") && !d.HasChange("routing_weight") && !d.HasChange("tags") &&
+		!d.HasChange("ipsec_policy") && !d.HasChange("use_policy_based_traffic_selectors") {
+		return resourceArmVirtualNetworkGatewayConnectionUpdateSharedKey(d, meta)
+	}
+
+	return resourceArmVirtualNetworkGatewayConnectionCreateUpdate(d, meta)
+}
+
+func resourceArmVirtualNetworkGatewayConnectionUpdateSharedKey(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).vnetGatewayConnectionsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	resGroup, name, err := resourceGroupAndVirtualNetworkGatewayConnectionFromId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	sharedKey := d.Get("shared_key").(string)
+	parameters := network.ConnectionSharedKey{
+		Value: &sharedKey,
+	}
+
+	future, err := client.SetSharedKey(ctx, resGroup, name, parameters)
+	if err != nil {
+		return fmt.Errorf("Error setting Shared Key for AzureStack Virtual Network Gateway Connection %q (Resource Group %q): %+v", name, resGroup, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for completion of Shared Key update for Virtual Network Gateway Connection %q (Resource Group %q): %+v", name, resGroup, err)
+	}
+
+	return resourceArmVirtualNetworkGatewayConnectionRead(d, meta)
+}
+
 func resourceArmVirtualNetworkGatewayConnectionRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).vnetGatewayConnectionsClient
 	ctx := meta.(*ArmClient).StopContext
@@ -160,7 +315,7 @@ func resourceArmVirtualNetworkGatewayConnectionRead(d *schema.ResourceData, meta
 
 	resp, err := client.Get(ctx, resGroup, name)
 	if err != nil {
-		if utils.ResponseWasNotFound(resp.Response) {
+		if wasNotFound(resp.Response) {
 			d.SetId("")
 			return nil
 		}
@@ -207,10 +362,18 @@ func resourceArmVirtualNetworkGatewayConnectionRead(d *schema.ResourceData, meta
 		d.Set("routing_weight", conn.RoutingWeight)
 	}
 
+	if conn.UsePolicyBasedTrafficSelectors != nil {
+		d.Set("use_policy_based_traffic_selectors", conn.UsePolicyBasedTrafficSelectors)
+	}
+
+	if err := d.Set("ipsec_policy", flattenArmVirtualNetworkGatewayConnectionIpsecPolicies(conn.IpsecPolicies)); err != nil {
+		return fmt.Errorf("Error setting `ipsec_policy`: %+v", err)
+	}
+
 	// Get Shared Key
 	sharedKeyResp, err := client.GetSharedKey(ctx, resGroup, name)
 	if err != nil {
-		if utils.ResponseWasNotFound(resp.Response) {
+		if wasNotFound(resp.Response) {
 			d.SetId("")
 			return nil
 		}
@@ -325,6 +488,12 @@ func getArmVirtualNetworkGatewayConnectionProperties(d *schema.ResourceData) (*n
 		props.SharedKey = utils.String(v.(string))
 	}
 
+	props.UsePolicyBasedTrafficSelectors = utils.Bool(d.Get("use_policy_based_traffic_selectors").(bool))
+
+	if policies := expandArmVirtualNetworkGatewayConnectionIpsecPolicies(d.Get("ipsec_policy").([]interface{})); policies != nil {
+		props.IpsecPolicies = policies
+	}
+
 	if props.ConnectionType == network.ExpressRoute {
 		if props.Peer == nil || props.Peer.ID == nil {
 			return nil, fmt.Errorf("`express_route_circuit_id` must be specified when `type` is set to `ExpressRoute")
@@ -350,6 +519,54 @@ func getArmVirtualNetworkGatewayConnectionProperties(d *schema.ResourceData) (*n
 	return props, nil
 }
 
+func expandArmVirtualNetworkGatewayConnectionIpsecPolicies(input []interface{}) *[]network.IpsecPolicy {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+
+	policy := network.IpsecPolicy{
+		SaLifeTimeSeconds:   utils.Int32(int32(v["sa_lifetime"].(int))),
+		SaDataSizeKilobytes: utils.Int32(int32(v["sa_datasize"].(int))),
+		IpsecEncryption:     network.IpsecEncryption(v["ipsec_encryption"].(string)),
+		IpsecIntegrity:      network.IpsecIntegrity(v["ipsec_integrity"].(string)),
+		IkeEncryption:       network.IkeEncryption(v["ike_encryption"].(string)),
+		IkeIntegrity:        network.IkeIntegrity(v["ike_integrity"].(string)),
+		DhGroup:             network.DhGroup(v["dh_group"].(string)),
+		PfsGroup:            network.PfsGroup(v["pfs_group"].(string)),
+	}
+
+	return &[]network.IpsecPolicy{policy}
+}
+
+func flattenArmVirtualNetworkGatewayConnectionIpsecPolicies(input *[]network.IpsecPolicy) []interface{} {
+	if input == nil || len(*input) == 0 {
+		return []interface{}{}
+	}
+
+	policy := (*input)[0]
+
+	output := map[string]interface{}{
+		"dh_group":         string(policy.DhGroup),
+		"ike_encryption":   string(policy.IkeEncryption),
+		"ike_integrity":    string(policy.IkeIntegrity),
+		"ipsec_encryption": string(policy.IpsecEncryption),
+		"ipsec_integrity":  string(policy.IpsecIntegrity),
+		"pfs_group":        string(policy.PfsGroup),
+	}
+
+	if policy.SaDataSizeKilobytes != nil {
+		output["sa_datasize"] = int(*policy.SaDataSizeKilobytes)
+	}
+
+	if policy.SaLifeTimeSeconds != nil {
+		output["sa_lifetime"] = int(*policy.SaLifeTimeSeconds)
+	}
+
+	return []interface{}{output}
+}
+
 func resourceGroupAndVirtualNetworkGatewayConnectionFromId(virtualNetworkGatewayConnectionId string) (string, string, error) {
 	id, err := parseAzureResourceID(virtualNetworkGatewayConnectionId)
 	if err != nil {
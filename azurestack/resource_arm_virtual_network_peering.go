@@ -0,0 +1,181 @@
+package azurestack
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/2019-03-01/network/mgmt/network"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+	"github.com/terraform-providers/terraform-provider-azurestack/azurestack/helpers/azure"
+)
+
+func resourceArmVirtualNetworkPeering() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmVirtualNetworkPeeringCreateUpdate,
+		Read:   resourceArmVirtualNetworkPeeringRead,
+		Update: resourceArmVirtualNetworkPeeringCreateUpdate,
+		Delete: resourceArmVirtualNetworkPeeringDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"virtual_network_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"remote_virtual_network_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"allow_virtual_network_access": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"allow_forwarded_traffic": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"allow_gateway_transit": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"use_remote_gateways": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+		},
+	}
+}
+
+func resourceArmVirtualNetworkPeeringCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).vnetPeeringClient
+	ctx := meta.(*ArmClient).StopContext
+
+	name := d.Get("name").(string)
+	resGroup := d.Get("resource_group_name").(string)
+	vnetName := d.Get("virtual_network_name").(string)
+
+	peering := network.VirtualNetworkPeering{
+		Name: utils.String(name),
+		VirtualNetworkPeeringPropertiesFormat: &network.VirtualNetworkPeeringPropertiesFormat{
+			RemoteVirtualNetwork: &network.SubResource{
+				ID: utils.String(d.Get("remote_virtual_network_id").(string)),
+			},
+			AllowVirtualNetworkAccess: utils.Bool(d.Get("allow_virtual_network_access").(bool)),
+			AllowForwardedTraffic:     utils.Bool(d.Get("allow_forwarded_traffic").(bool)),
+			AllowGatewayTransit:       utils.Bool(d.Get("allow_gateway_transit").(bool)),
+			UseRemoteGateways:         utils.Bool(d.Get("use_remote_gateways").(bool)),
+		},
+	}
+
+	future, err := client.CreateOrUpdate(ctx, resGroup, vnetName, name, peering)
+	if err != nil {
+		return fmt.Errorf("Error creating/updating Virtual Network Peering %q (Virtual Network %q / Resource Group %q): %+v", name, vnetName, resGroup, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for completion of Virtual Network Peering %q (Virtual Network %q / Resource Group %q): %+v", name, vnetName, resGroup, err)
+	}
+
+	read, err := client.Get(ctx, resGroup, vnetName, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Virtual Network Peering %q (Virtual Network %q / Resource Group %q): %+v", name, vnetName, resGroup, err)
+	}
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read Virtual Network Peering %q (Virtual Network %q / Resource Group %q) ID", name, vnetName, resGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmVirtualNetworkPeeringRead(d, meta)
+}
+
+func resourceArmVirtualNetworkPeeringRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).vnetPeeringClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resGroup := id.ResourceGroup
+	vnetName := id.Path["virtualNetworks"]
+	name := id.Path["virtualNetworkPeerings"]
+
+	resp, err := client.Get(ctx, resGroup, vnetName, name)
+	if err != nil {
+		if wasNotFound(resp.Response) {
+			log.Printf("[INFO] Virtual Network Peering %q not found. Removing from state", name)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Virtual Network Peering %q (Virtual Network %q / Resource Group %q): %+v", name, vnetName, resGroup, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", resGroup)
+	d.Set("virtual_network_name", vnetName)
+
+	if props := resp.VirtualNetworkPeeringPropertiesFormat; props != nil {
+		if remote := props.RemoteVirtualNetwork; remote != nil {
+			d.Set("remote_virtual_network_id", remote.ID)
+		}
+
+		d.Set("allow_virtual_network_access", props.AllowVirtualNetworkAccess)
+		d.Set("allow_forwarded_traffic", props.AllowForwardedTraffic)
+		d.Set("allow_gateway_transit", props.AllowGatewayTransit)
+		d.Set("use_remote_gateways", props.UseRemoteGateways)
+	}
+
+	return nil
+}
+
+func resourceArmVirtualNetworkPeeringDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).vnetPeeringClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resGroup := id.ResourceGroup
+	vnetName := id.Path["virtualNetworks"]
+	name := id.Path["virtualNetworkPeerings"]
+
+	future, err := client.Delete(ctx, resGroup, vnetName, name)
+	if err != nil {
+		return fmt.Errorf("Error deleting Virtual Network Peering %q (Virtual Network %q / Resource Group %q): %+v", name, vnetName, resGroup, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for deletion of Virtual Network Peering %q (Virtual Network %q / Resource Group %q): %+v", name, vnetName, resGroup, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,124 @@
+package azurestack
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func TestAccAzureStackVirtualNetworkPeering_basic(t *testing.T) {
+	resourceName := "azurestack_virtual_network_peering.test1"
+	ri := acctest.RandInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureStackVirtualNetworkPeeringDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureStackVirtualNetworkPeering_basic(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureStackVirtualNetworkPeeringExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "allow_virtual_network_access", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureStackVirtualNetworkPeeringExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		peeringName := rs.Primary.Attributes["name"]
+		vnetName := rs.Primary.Attributes["virtual_network_name"]
+		resourceGroup, hasResourceGroup := rs.Primary.Attributes["resource_group_name"]
+		if !hasResourceGroup {
+			return fmt.Errorf("Bad: no resource group found in state for Virtual Network Peering: %s", peeringName)
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).vnetPeeringClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, resourceGroup, vnetName, peeringName)
+		if err != nil {
+			return fmt.Errorf("Bad: Get on vnetPeeringClient: %s", err)
+		}
+
+		if resp.StatusCode == http.StatusNotFound {
+			return fmt.Errorf("Bad: Virtual Network Peering %q (Virtual Network %q / Resource Group %q) does not exist", peeringName, vnetName, resourceGroup)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureStackVirtualNetworkPeeringDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).vnetPeeringClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurestack_virtual_network_peering" {
+			continue
+		}
+
+		name := rs.Primary.Attributes["name"]
+		vnetName := rs.Primary.Attributes["virtual_network_name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		resp, err := client.Get(ctx, resourceGroup, vnetName, name)
+		if err != nil {
+			return nil
+		}
+
+		if resp.StatusCode != http.StatusNotFound {
+			return fmt.Errorf("Virtual Network Peering still exists:\n%#v", resp.VirtualNetworkPeeringPropertiesFormat)
+		}
+	}
+
+	return nil
+}
+
+func testAccAzureStackVirtualNetworkPeering_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurestack_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurestack_virtual_network" "test1" {
+  name                = "acctestvnet1-%[1]d"
+  resource_group_name = "${azurestack_resource_group.test.name}"
+  location            = "${azurestack_resource_group.test.location}"
+  address_space       = ["10.0.1.0/24"]
+}
+
+resource "azurestack_virtual_network" "test2" {
+  name                = "acctestvnet2-%[1]d"
+  resource_group_name = "${azurestack_resource_group.test.name}"
+  location            = "${azurestack_resource_group.test.location}"
+  address_space       = ["10.0.2.0/24"]
+}
+
+resource "azurestack_virtual_network_peering" "test1" {
+  name                      = "acctestpeer1to2-%[1]d"
+  resource_group_name       = "${azurestack_resource_group.test.name}"
+  virtual_network_name      = "${azurestack_virtual_network.test1.name}"
+  remote_virtual_network_id = "${azurestack_virtual_network.test2.id}"
+}
+
+resource "azurestack_virtual_network_peering" "test2" {
+  name                      = "acctestpeer2to1-%[1]d"
+  resource_group_name       = "${azurestack_resource_group.test.name}"
+  virtual_network_name      = "${azurestack_virtual_network.test2.name}"
+  remote_virtual_network_id = "${azurestack_virtual_network.test1.id}"
+}
+`, rInt, location)
+}
@@ -99,6 +99,37 @@ func TestAccAzureStackVirtualNetwork_withTags(t *testing.T) {
 	})
 }
 
+func TestAccAzureStackVirtualNetwork_addressSpaceExpansion(t *testing.T) {
+	resourceName := "azurestack_virtual_network.test"
+	location := testLocation()
+	ri := acctest.RandInt()
+	preConfig := testAccAzureStackVirtualNetwork_addressSpace(ri, location)
+	postConfig := testAccAzureStackVirtualNetwork_addressSpaceExpanded(ri, location)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureStackVirtualNetworkDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: preConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureStackVirtualNetworkExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "address_space.#", "1"),
+				),
+			},
+			{
+				Config: postConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureStackVirtualNetworkExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "address_space.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "address_space.1", "10.1.0.0/16"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAzureStackVirtualNetwork_bug373(t *testing.T) {
 	resourceName := "azurestack_virtual_network.test"
 	rs := acctest.RandString(6)
@@ -345,3 +376,35 @@ resource "azurestack_network_security_group" "test" {
 }
 `, rString, location)
 }
+
+func testAccAzureStackVirtualNetwork_addressSpace(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurestack_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurestack_virtual_network" "test" {
+  name                = "acctestvirtnet%d"
+  address_space       = ["10.0.0.0/16"]
+  location            = "${azurestack_resource_group.test.location}"
+  resource_group_name = "${azurestack_resource_group.test.name}"
+}
+`, rInt, location, rInt)
+}
+
+func testAccAzureStackVirtualNetwork_addressSpaceExpanded(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurestack_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurestack_virtual_network" "test" {
+  name                = "acctestvirtnet%d"
+  address_space       = ["10.0.0.0/16", "10.1.0.0/16"]
+  location            = "${azurestack_resource_group.test.location}"
+  resource_group_name = "${azurestack_resource_group.test.name}"
+}
+`, rInt, location, rInt)
+}
@@ -99,6 +99,25 @@ func parseAzureResourceID(id string) (*ResourceID, error) {
 	return idObj, nil
 }
 
+// PathKeyCaseInsensitive looks up a segment of the ID's Path by key, tolerating
+// case differences between the key requested and the key as it was cased in
+// the ID - which some Azure Stack Hub versions return inconsistently (for
+// example "storageaccounts" instead of "storageAccounts") for the same
+// Resource Provider. Returns "" if no case variant of key is present.
+func (id ResourceID) PathKeyCaseInsensitive(key string) string {
+	if value, ok := id.Path[key]; ok {
+		return value
+	}
+
+	for k, v := range id.Path {
+		if strings.EqualFold(k, key) {
+			return v
+		}
+	}
+
+	return ""
+}
+
 func parseNetworkSecurityGroupName(networkSecurityGroupId string) (string, error) {
 	id, err := parseAzureResourceID(networkSecurityGroupId)
 	if err != nil {
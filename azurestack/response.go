@@ -0,0 +1,23 @@
+package azurestack
+
+import (
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+// wasNotFound is the single place a Read/Exists function should call to decide whether a failed
+// request means the resource is genuinely gone (a 404), as opposed to some other failure - such
+// as a transient gateway 5xx - which must be surfaced as an error and retried rather than
+// silently dropping the resource from state.
+func wasNotFound(resp autorest.Response) bool {
+	return utils.ResponseWasNotFound(resp)
+}
+
+// wasNotFoundRaw is wasNotFound's counterpart for callers holding the raw *http.Response of a
+// long-running operation, such as a Delete future's Response(), rather than an autorest.Response.
+func wasNotFoundRaw(resp *http.Response) bool {
+	return response.WasNotFound(resp)
+}
@@ -0,0 +1,222 @@
+package azurestack
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"regexp"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+// senderSettings controls the behaviour of the autorest.Sender used by every client registered on
+// the ArmClient - both the TLS trust used by disconnected/enterprise Azure Stack stamps whose
+// certificates aren't signed by a public CA, and the HTTP(S) proxy requests are routed through.
+type senderSettings struct {
+	CustomCACertPath   string
+	InsecureSkipVerify bool
+
+	// ProxyURL overrides the proxy used for every request. When unset the standard
+	// HTTP_PROXY/HTTPS_PROXY/NO_PROXY Environment Variables (via http.ProxyFromEnvironment) apply.
+	ProxyURL string
+
+	// MaxRetries, RetryWaitMin and RetryWaitMax control the exponential-backoff retry applied to
+	// every request made by every client registered on the ArmClient, on top of any retry autorest
+	// itself performs for Long-Running Operations.
+	MaxRetries   int
+	RetryWaitMin time.Duration
+	RetryWaitMax time.Duration
+
+	// TraceRequestLogging, when enabled, logs the full (redacted) request/response bodies for every
+	// request at `[TRACE]` level rather than the terse method/URL summary logged at `[DEBUG]` by
+	// default - useful when diagnosing stamp-specific API behavior without an external proxy.
+	TraceRequestLogging bool
+}
+
+// buildSender returns the autorest.Sender used by every client registered on the ArmClient. It
+// mirrors `github.com/hashicorp/go-azure-helpers/sender`.BuildSender, but additionally supports
+// trusting a custom CA bundle (for stamps whose TLS certificates are signed by an enterprise or
+// stamp-local CA), skipping TLS verification entirely as an explicit escape hatch, and routing
+// requests through an explicitly configured proxy.
+func buildSender(providerName string, settings senderSettings) (autorest.Sender, error) {
+	tlsConfig := &tls.Config{}
+
+	if settings.InsecureSkipVerify {
+		tlsConfig.InsecureSkipVerify = true
+	}
+
+	if settings.CustomCACertPath != "" {
+		certPool, err := certPoolWithCustomCA(settings.CustomCACertPath)
+		if err != nil {
+			return nil, err
+		}
+		tlsConfig.RootCAs = certPool
+	}
+
+	proxy := http.ProxyFromEnvironment
+	if settings.ProxyURL != "" {
+		proxyURL, err := url.Parse(settings.ProxyURL)
+		if err != nil {
+			return nil, fmt.Errorf("Error parsing `proxy_url` %q: %+v", settings.ProxyURL, err)
+		}
+		proxy = http.ProxyURL(proxyURL)
+	}
+
+	client := &http.Client{
+		Transport: &http.Transport{
+			Proxy:           proxy,
+			TLSClientConfig: tlsConfig,
+		},
+	}
+
+	return autorest.DecorateSender(client,
+		withRequestLogging(providerName, settings.TraceRequestLogging),
+		autorest.DoRetryForStatusCodesWithCap(settings.MaxRetries, settings.RetryWaitMin, settings.RetryWaitMax, autorest.StatusCodesForRetry...),
+	), nil
+}
+
+// certPoolWithCustomCA returns the system's CA bundle with the certificate(s) at customCACertPath
+// additionally trusted, so the ARM, storage and Key Vault clients can validate certificates issued
+// by a disconnected Azure Stack instance's own CA.
+func certPoolWithCustomCA(customCACertPath string) (*x509.CertPool, error) {
+	certPool, err := x509.SystemCertPool()
+	if err != nil || certPool == nil {
+		certPool = x509.NewCertPool()
+	}
+
+	contents, err := ioutil.ReadFile(customCACertPath)
+	if err != nil {
+		return nil, fmt.Errorf("Error reading Custom CA Certificate at %q: %+v", customCACertPath, err)
+	}
+
+	if ok := certPool.AppendCertsFromPEM(contents); !ok {
+		return nil, fmt.Errorf("Error appending Custom CA Certificate at %q: no certificates were found", customCACertPath)
+	}
+
+	return certPool, nil
+}
+
+// withCorrelationRequestID tags every outgoing request with the given `x-ms-correlation-request-id`
+// header - generated once per Terraform operation by buildArmClient, rather than per-request - so
+// that every request an `apply` makes can be correlated as a single operation on the Azure Stack
+// side when diagnosing an issue.
+func withCorrelationRequestID(id string) autorest.PrepareDecorator {
+	return func(p autorest.Preparer) autorest.Preparer {
+		return autorest.PreparerFunc(func(r *http.Request) (*http.Request, error) {
+			r, err := p.Prepare(r)
+			if err != nil {
+				return r, err
+			}
+
+			r.Header.Set("x-ms-correlation-request-id", id)
+
+			return r, nil
+		})
+	}
+}
+
+func withRequestLogging(providerName string, traceRequestLogging bool) autorest.SendDecorator {
+	return func(s autorest.Sender) autorest.Sender {
+		return autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+			if traceRequestLogging {
+				// dump the full (redacted) request/response bodies at `[TRACE]`, the most verbose
+				// level Terraform's logging recognises
+				if dump, err := httputil.DumpRequestOut(r, true); err == nil {
+					log.Printf("[TRACE] %s Request: \n%s\n", providerName, redactSensitiveData(dump))
+				} else {
+					log.Printf("[TRACE] %s Request: %s to %s\n", providerName, r.Method, r.URL)
+				}
+
+				resp, err := s.Do(r)
+				if resp != nil {
+					if dump, err2 := httputil.DumpResponse(resp, true); err2 == nil {
+						log.Printf("[TRACE] %s Response for %s: \n%s\n", providerName, r.URL, redactSensitiveData(dump))
+					} else {
+						log.Printf("[TRACE] %s Response: %s for %s\n", providerName, resp.Status, r.URL)
+					}
+				} else {
+					log.Printf("[TRACE] Request to %s completed with no response", r.URL)
+				}
+				return resp, err
+			}
+
+			// strip the authorization header prior to printing
+			authHeaderName := "Authorization"
+			auth := r.Header.Get(authHeaderName)
+			if auth != "" {
+				r.Header.Del(authHeaderName)
+			}
+
+			// dump request to wire format
+			if dump, err := httputil.DumpRequestOut(r, true); err == nil {
+				log.Printf("[DEBUG] %s Request: \n%s\n", providerName, dump)
+			} else {
+				// fallback to basic message
+				log.Printf("[DEBUG] %s Request: %s to %s\n", providerName, r.Method, r.URL)
+			}
+
+			// add the auth header back
+			if auth != "" {
+				r.Header.Add(authHeaderName, auth)
+			}
+
+			resp, err := s.Do(r)
+			if resp != nil {
+				// dump response to wire format
+				if dump, err2 := httputil.DumpResponse(resp, true); err2 == nil {
+					log.Printf("[DEBUG] %s Response for %s: \n%s\n", providerName, r.URL, dump)
+				} else {
+					// fallback to basic message
+					log.Printf("[DEBUG] %s Response: %s for %s\n", providerName, resp.Status, r.URL)
+				}
+			} else {
+				log.Printf("[DEBUG] Request to %s completed with no response", r.URL)
+			}
+			return resp, err
+		})
+	}
+}
+
+// redactSensitiveData scrubs values which shouldn't be written to logs - Authorization headers,
+// Shared Access Signature query string parameters, Shared Key/Ocp-Apim-Subscription-Key headers
+// used by the Storage and Key Vault clients, and the client secrets/assertions and access/refresh
+// tokens exchanged in the body of the OAuth2 client-credentials and OIDC JWT-bearer token requests
+// made for Client Secret authentication and by `auth_oidc.go` - from a dumped HTTP request/response.
+func redactSensitiveData(dump []byte) []byte {
+	output := string(dump)
+
+	for _, pattern := range sensitiveDataPatterns {
+		output = pattern.pattern.ReplaceAllString(output, pattern.replacement)
+	}
+
+	return []byte(output)
+}
+
+type sensitiveDataPattern struct {
+	pattern     *regexp.Regexp
+	replacement string
+}
+
+var sensitiveDataPatterns = []sensitiveDataPattern{
+	// Authorization / Ocp-Apim-Subscription-Key headers (bearer tokens, subscription keys)
+	{regexp.MustCompile(`(?im)^((?:Authorization|Ocp-Apim-Subscription-Key):\s*).*$`), "${1}[REDACTED]"},
+
+	// Shared Key / SharedKeyLite Authorization values which slipped through in a body dump
+	{regexp.MustCompile(`(?i)(SharedKey(?:Lite)? [^:\s"]+:)[^\s"'&]+`), "${1}[REDACTED]"},
+
+	// SAS token signatures (`sig=`) in a request/response URL or body
+	{regexp.MustCompile(`(?i)(sig=)[^&\s"']+`), "${1}[REDACTED]"},
+
+	// client_secret/client_assertion and access_token/refresh_token in a form-encoded token
+	// request or response body
+	{regexp.MustCompile(`(?i)((?:client_secret|client_assertion|access_token|refresh_token)=)[^&\s"']+`), "${1}[REDACTED]"},
+
+	// the same values in a JSON token response body
+	{regexp.MustCompile(`(?i)("(?:client_secret|client_assertion|access_token|refresh_token)"\s*:\s*")[^"]*(")`), "${1}[REDACTED]${2}"},
+}
@@ -0,0 +1,76 @@
+package azurestack
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRedactSensitiveData(t *testing.T) {
+	cases := []struct {
+		name     string
+		input    string
+		contains []string
+		absent   []string
+	}{
+		{
+			name:     "authorization header",
+			input:    "GET / HTTP/1.1\r\nAuthorization: Bearer abc123\r\nHost: example.com\r\n",
+			contains: []string{"Authorization: [REDACTED]", "Host: example.com"},
+			absent:   []string{"abc123"},
+		},
+		{
+			name:     "ocp-apim-subscription-key header",
+			input:    "Ocp-Apim-Subscription-Key: sub-key-value\r\n",
+			contains: []string{"Ocp-Apim-Subscription-Key: [REDACTED]"},
+			absent:   []string{"sub-key-value"},
+		},
+		{
+			name:     "shared key authorization value",
+			input:    `Authorization: SharedKey account:c2lnbmF0dXJlaGVyZQ==`,
+			contains: []string{"[REDACTED]"},
+			absent:   []string{"c2lnbmF0dXJlaGVyZQ=="},
+		},
+		{
+			name:     "sas signature query parameter",
+			input:    "https://example.blob.core.windows.net/container/blob?sig=s3cr3tSignature&se=2021-01-01",
+			contains: []string{"sig=[REDACTED]", "se=2021-01-01"},
+			absent:   []string{"s3cr3tSignature"},
+		},
+		{
+			name:     "client_secret in a form-encoded token request body",
+			input:    "grant_type=client_credentials&client_id=abc&client_secret=sup3rS3cr3t&resource=https%3A%2F%2Fmanagement.azure.com%2F",
+			contains: []string{"client_secret=[REDACTED]", "grant_type=client_credentials"},
+			absent:   []string{"sup3rS3cr3t"},
+		},
+		{
+			name:     "client_assertion JWT in a form-encoded token request body",
+			input:    "grant_type=client_credentials&client_assertion_type=urn%3Aietf%3Aparams%3Aoauth%3Aclient-assertion-type%3Ajwt-bearer&client_assertion=eyJhbGciOiJSUzI1NiJ9.abc.def",
+			contains: []string{"client_assertion=[REDACTED]"},
+			absent:   []string{"eyJhbGciOiJSUzI1NiJ9.abc.def"},
+		},
+		{
+			name:     "access_token and refresh_token in a JSON token response body",
+			input:    `{"token_type":"Bearer","access_token":"ey.access.token","refresh_token":"ey.refresh.token","expires_in":3600}`,
+			contains: []string{`"access_token":"[REDACTED]"`, `"refresh_token":"[REDACTED]"`, `"token_type":"Bearer"`},
+			absent:   []string{"ey.access.token", "ey.refresh.token"},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			output := string(redactSensitiveData([]byte(tc.input)))
+
+			for _, want := range tc.contains {
+				if !strings.Contains(output, want) {
+					t.Errorf("expected output to contain %q, got: %s", want, output)
+				}
+			}
+
+			for _, notWant := range tc.absent {
+				if strings.Contains(output, notWant) {
+					t.Errorf("expected output to not contain %q, got: %s", notWant, output)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,33 @@
+package azurestack
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+// waitForStateContext runs conf.WaitForState() but returns as soon as ctx is cancelled, rather than
+// leaving a Ctrl-C'd apply blocked until conf.Timeout (up to the resource's configured Create/Update/
+// Delete timeout) elapses. resource.StateChangeConf in the vendored SDK has no context-aware
+// equivalent of WaitForState, so this runs it in a goroutine and races it against ctx.Done() -
+// the polling goroutine itself is left to unwind against its own Timeout, but the caller (and
+// Terraform's own interrupt handling) is unblocked immediately.
+func waitForStateContext(ctx context.Context, conf *resource.StateChangeConf) (interface{}, error) {
+	type result struct {
+		value interface{}
+		err   error
+	}
+
+	resCh := make(chan result, 1)
+	go func() {
+		value, err := conf.WaitForState()
+		resCh <- result{value: value, err: err}
+	}()
+
+	select {
+	case res := <-resCh:
+		return res.value, res.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
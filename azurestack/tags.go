@@ -57,8 +57,18 @@ func validateAzureStackTags(v interface{}, k string) (ws []string, es []error) {
 	return
 }
 
-func expandTags(tagsMap map[string]interface{}) *map[string]*string {
-	output := make(map[string]*string, len(tagsMap))
+// expandTags merges the Provider's `default_tags` into tagsMap, with tagsMap (the Resource's own
+// `tags`) winning on any conflicting key, and returns the result in the shape the Azure Stack SDKs
+// expect.
+func expandTags(meta interface{}, tagsMap map[string]interface{}) *map[string]*string {
+	defaultTags := meta.(*ArmClient).defaultTags
+
+	output := make(map[string]*string, len(defaultTags)+len(tagsMap))
+
+	for i, v := range defaultTags {
+		value := v
+		output[i] = &value
+	}
 
 	for i, v := range tagsMap {
 		//Validate should have ignored this error already